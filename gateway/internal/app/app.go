@@ -69,6 +69,34 @@ func (a *App) Start(ctx context.Context) error {
 		return err
 	}
 
+	a.l.Info().
+		Str("endpoint", a.cfg.WeatherServer.Address()).
+		Msg("registering AdminService handler")
+	if err := weatherpb.RegisterAdminServiceHandlerFromEndpoint(
+		ctx,
+		mux,
+		a.cfg.WeatherServer.Address(),
+		dialOpts); err != nil {
+		a.l.Error().
+			Err(err).
+			Msg("failed to register AdminService handler")
+		return err
+	}
+
+	a.l.Info().
+		Str("endpoint", a.cfg.WeatherServer.Address()).
+		Msg("registering CacheAdminService handler")
+	if err := weatherpb.RegisterCacheAdminServiceHandlerFromEndpoint(
+		ctx,
+		mux,
+		a.cfg.WeatherServer.Address(),
+		dialOpts); err != nil {
+		a.l.Error().
+			Err(err).
+			Msg("failed to register CacheAdminService handler")
+		return err
+	}
+
 	httpMux := http.NewServeMux()
 	httpMux.Handle("/swagger/", httpSwagger.Handler(
 		httpSwagger.URL("http://"+a.cfg.ServerAddress()+"/swagger/swagger.json"),
@@ -106,6 +134,30 @@ func (a *App) Start(ctx context.Context) error {
 	httpMux.Handle("/api/v1/http/weather", m.InstrumentHandler(
 		http.HandlerFunc(weathHandler.HandleGetWeather)))
 
+	weatherConn, err := grpc.NewClient(a.cfg.WeatherServer.Address(), dialOpts...)
+	if err != nil {
+		a.l.Error().
+			Err(err).
+			Msg("failed to create weather gRPC client")
+		return err
+	}
+	defer func() {
+		if err := weatherConn.Close(); err != nil {
+			a.l.Error().
+				Err(err).
+				Msg("failed to close weather gRPC connection")
+		}
+	}()
+	weatherGRPCHandler := weatherHTTP.NewGRPCHandler(weatherpb.NewWeatherServiceClient(weatherConn), a.l, m)
+	httpMux.Handle("/api/v1/http/weather/watch", m.InstrumentHandler(
+		http.HandlerFunc(weatherGRPCHandler.HandleWatch)))
+	httpMux.Handle("/api/v1/http/weather/cities", m.InstrumentHandler(
+		http.HandlerFunc(weatherGRPCHandler.HandleSearchCities)))
+	httpMux.Handle("/api/v1/http/weather/coordinates", m.InstrumentHandler(
+		http.HandlerFunc(weatherGRPCHandler.HandleCoordinates)))
+	httpMux.Handle("/api/v1/http/weather/history", m.InstrumentHandler(
+		http.HandlerFunc(weatherGRPCHandler.HandleHistory)))
+
 	httpMux.Handle("/v2/", mux)
 	// Launch server
 	go func() {
@@ -83,6 +83,7 @@ func (h *Handler) HandleSubscribe(w http.ResponseWriter, r *http.Request) {
 		Email:     r.FormValue("email"),
 		City:      r.FormValue("city"),
 		Frequency: r.FormValue("frequency"),
+		Units:     r.FormValue("units"),
 	}
 
 	if userData.Email == "" || userData.City == "" || userData.Frequency == "" {
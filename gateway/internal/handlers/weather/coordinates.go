@@ -0,0 +1,59 @@
+package weather
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+)
+
+// HandleCoordinates handles GET /api/v1/http/weather/coordinates?lat={lat}&lon={lon}.
+func (h *GRPCHandler) HandleCoordinates(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	lat, latErr := strconv.ParseFloat(r.URL.Query().Get("lat"), 64)
+	lon, lonErr := strconv.ParseFloat(r.URL.Query().Get("lon"), 64)
+	if latErr != nil || lonErr != nil {
+		h.m.WeatherFailures.WithLabelValues(r.Method, r.URL.Path, "4xx").Inc()
+		http.Error(w, "lat and lon query parameters must be numbers", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutDuration)
+	defer cancel()
+
+	// Range checks are left to the weather service.
+	resp, err := h.client.GetByCoordinates(ctx, &weatherpb.CoordinatesRequest{Lat: lat, Lon: lon})
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	data, err := jsonMarshaler.Marshal(resp)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		h.logger.Error().Err(err).Str("path", r.URL.Path).Msg("error writing response body")
+		return
+	}
+
+	h.m.WeatherProcessingTime.WithLabelValues(r.Method, r.URL.Path, "2xx").Observe(time.Since(start).Seconds())
+	h.logger.Info().
+		Float64("lat", lat).
+		Float64("lon", lon).
+		Str("city", resp.City).
+		Dur("duration_ms", time.Since(start)).
+		Msg("coordinates lookup served")
+}
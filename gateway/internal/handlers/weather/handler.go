@@ -34,7 +34,7 @@ func NewHandler(
 	return &Handler{client: client, baseURL: weatherServiceBaseURL, logger: logger, m: m}
 }
 
-// HandleGetWeather handles GET /api/v1/http/weather?city={city}.
+// HandleGetWeather handles GET /api/v1/http/weather?city={city}&units={units}&lang={lang}.
 func (h *Handler) HandleGetWeather(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
 	clientIP := r.RemoteAddr
@@ -121,7 +121,13 @@ func (h *Handler) HandleGetWeather(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Failed to parse weather service URL", http.StatusInternalServerError)
 		return
 	}
-	targetURL.RawQuery = url.Values{"city": {city}}.Encode()
+	query := url.Values{"city": {city}}
+	for _, key := range []string{"units", "lang"} {
+		if value := r.URL.Query().Get(key); value != "" {
+			query.Set(key, value)
+		}
+	}
+	targetURL.RawQuery = query.Encode()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL.String(), nil)
 	if err != nil {
@@ -163,7 +169,7 @@ func (h *Handler) HandleGetWeather(w http.ResponseWriter, r *http.Request) {
 			Dur("duration_ms", time.Since(start)).
 			Msg("forwarding request failed")
 
-		http.Error(w, "Failed to contact weather service", http.StatusInternalServerError)
+		http.Error(w, "Failed to contact weather service", http.StatusBadGateway)
 		return
 	}
 	defer func(body io.ReadCloser) {
@@ -0,0 +1,68 @@
+package weather
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+)
+
+// HandleHistory handles GET /api/v1/http/weather/history?city={city}&from={RFC 3339}&to={RFC 3339}&granularity={raw|hour|day}.
+func (h *GRPCHandler) HandleHistory(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	req := &weatherpb.HistoryRequest{City: query.Get("city"), Granularity: query.Get("granularity")}
+	for name, field := range map[string]**timestamppb.Timestamp{"from": &req.From, "to": &req.To} {
+		raw := query.Get(name)
+		if raw == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			h.m.WeatherFailures.WithLabelValues(r.Method, r.URL.Path, "4xx").Inc()
+			http.Error(w, name+" must be an RFC 3339 time", http.StatusBadRequest)
+			return
+		}
+		*field = timestamppb.New(t)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutDuration)
+	defer cancel()
+
+	// City, granularity and range checks are left to the weather service.
+	resp, err := h.client.GetHistory(ctx, req)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	data, err := jsonMarshaler.Marshal(resp)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		h.logger.Error().Err(err).Str("path", r.URL.Path).Msg("error writing response body")
+		return
+	}
+
+	h.m.WeatherProcessingTime.WithLabelValues(r.Method, r.URL.Path, "2xx").Observe(time.Since(start).Seconds())
+	h.logger.Info().
+		Str("city", resp.City).
+		Str("granularity", resp.Granularity).
+		Int("points", len(resp.Points)).
+		Dur("duration_ms", time.Since(start)).
+		Msg("history served")
+}
@@ -0,0 +1,67 @@
+package weather
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+)
+
+// HandleSearchCities handles GET /api/v1/http/weather/cities?prefix={prefix}&limit={n}.
+func (h *GRPCHandler) HandleSearchCities(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	prefix := r.URL.Query().Get("prefix")
+	if prefix == "" {
+		h.m.WeatherFailures.WithLabelValues(r.Method, r.URL.Path, "4xx").Inc()
+		http.Error(w, "prefix query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	var limit int32
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			h.m.WeatherFailures.WithLabelValues(r.Method, r.URL.Path, "4xx").Inc()
+			http.Error(w, "limit must be a number", http.StatusBadRequest)
+			return
+		}
+		limit = int32(parsed)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutDuration)
+	defer cancel()
+
+	resp, err := h.client.SearchCities(ctx, &weatherpb.SearchCitiesRequest{Prefix: prefix, Limit: limit})
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	data, err := jsonMarshaler.Marshal(resp)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		h.logger.Error().Err(err).Str("path", r.URL.Path).Msg("error writing response body")
+		return
+	}
+
+	h.m.WeatherProcessingTime.WithLabelValues(r.Method, r.URL.Path, "2xx").Observe(time.Since(start).Seconds())
+	h.logger.Info().
+		Str("prefix", prefix).
+		Int("results", len(resp.Cities)).
+		Dur("duration_ms", time.Since(start)).
+		Msg("city search served")
+}
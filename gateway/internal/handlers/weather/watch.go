@@ -0,0 +1,144 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/gateway/internal/metrics"
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+)
+
+// jsonMarshaler keeps field names identical to the JSON served by /api/v1/http/weather.
+var jsonMarshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// GRPCHandler serves the weather routes that talk to the weather service over
+// gRPC instead of proxying its HTTP API.
+type GRPCHandler struct {
+	client weatherpb.WeatherServiceClient
+	logger zerolog.Logger
+	m      *metrics.Metrics
+}
+
+// NewGRPCHandler creates a new handler on top of the weather gRPC client.
+func NewGRPCHandler(
+	client weatherpb.WeatherServiceClient,
+	logger zerolog.Logger,
+	m *metrics.Metrics,
+) *GRPCHandler {
+	return &GRPCHandler{client: client, logger: logger, m: m}
+}
+
+// HandleWatch relays the WatchCity stream as Server-Sent Events.
+// It handles GET /api/v1/http/weather/watch?city={city}&threshold={degrees}.
+func (h *GRPCHandler) HandleWatch(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	city := r.URL.Query().Get("city")
+	if city == "" {
+		h.m.WeatherFailures.WithLabelValues(r.Method, r.URL.Path, "4xx").Inc()
+		http.Error(w, "city query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	var threshold float64
+	if raw := r.URL.Query().Get("threshold"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || parsed < 0 {
+			h.m.WeatherFailures.WithLabelValues(r.Method, r.URL.Path, "4xx").Inc()
+			http.Error(w, "threshold must be a non-negative number", http.StatusBadRequest)
+			return
+		}
+		threshold = parsed
+	}
+
+	stream, err := h.client.WatchCity(r.Context(), &weatherpb.WatchRequest{
+		City:                 city,
+		TemperatureThreshold: threshold,
+	})
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	// The first message tells us whether the city can be watched at all, so the
+	// error can still be reported with a proper status code.
+	first, err := stream.Recv()
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	h.logger.Info().
+		Str("city", city).
+		Float64("threshold", threshold).
+		Msg("weather watch started")
+
+	rc := http.NewResponseController(w)
+	resp := first
+	for {
+		if err := writeEvent(w, rc, resp); err != nil {
+			h.logger.Warn().Err(err).Str("city", city).Msg("failed to write weather event")
+			return
+		}
+		if resp, err = stream.Recv(); err != nil {
+			break
+		}
+	}
+
+	if r.Context().Err() == nil && !errors.Is(err, io.EOF) {
+		h.logger.Error().Err(err).Str("city", city).Msg("weather watch stream failed")
+		h.m.WeatherFailures.WithLabelValues(r.Method, r.URL.Path, "5xx").Inc()
+		_, _ = fmt.Fprintf(w, "event: error\ndata: {\"error\":%q}\n\n", status.Convert(err).Message())
+		_ = rc.Flush()
+	}
+
+	h.logger.Info().
+		Str("city", city).
+		Dur("duration_ms", time.Since(start)).
+		Msg("weather watch ended")
+}
+
+func writeEvent(w io.Writer, rc *http.ResponseController, resp *weatherpb.WeatherResponse) error {
+	data, err := jsonMarshaler.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: weather\ndata: %s\n\n", data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
+
+// fail reports a gRPC error before any response was written, mapping its code
+// the same way grpc-gateway does.
+func (h *GRPCHandler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	code := runtime.HTTPStatusFromCode(status.Code(err))
+
+	h.logger.Error().
+		Err(err).
+		Str("path", r.URL.Path).
+		Int("status", code).
+		Msg("weather gRPC call failed")
+	h.m.WeatherFailures.WithLabelValues(r.Method, r.URL.Path, h.m.GetStatusClass(code)).Inc()
+
+	http.Error(w, status.Convert(err).Message(), code)
+}
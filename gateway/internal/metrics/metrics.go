@@ -12,6 +12,11 @@ type ResponseWriter struct {
 	Status int
 }
 
+// Unwrap exposes the underlying writer so http.ResponseController can flush streamed responses.
+func (rw *ResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 type Metrics struct {
 	// SLI Metrics - Service Level Indicators
 	HTTPRequestsTotal   *prometheus.CounterVec
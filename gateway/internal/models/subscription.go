@@ -14,4 +14,5 @@ type UserSubData struct {
 	Email     string `json:"email" binding:"required,email"`
 	City      string `json:"city" binding:"required"`
 	Frequency string `json:"frequency" binding:"required,oneof=hourly daily"`
+	Units     string `json:"units,omitempty" binding:"omitempty,oneof=metric imperial standard"`
 }
@@ -94,9 +94,18 @@ func (c *Consumer) ReceiveWeather(d rabbitmq.Delivery) rabbitmq.Action {
 	}
 
 	fc := models.WeatherData{
-		City:        evt.Weather.City,
-		Temperature: evt.Weather.Temperature,
-		Condition:   evt.Weather.Description,
+		City:          evt.Weather.City,
+		Temperature:   evt.Weather.Temperature,
+		Condition:     evt.Weather.Description,
+		ConditionCode: evt.Weather.ConditionCode,
+		Humidity:      evt.Weather.Humidity,
+		WindSpeed:     evt.Weather.WindSpeed,
+		WindDirection: evt.Weather.WindDirection,
+		Pressure:      evt.Weather.Pressure,
+		FeelsLike:     evt.Weather.FeelsLike,
+		ObservedAt:    evt.Weather.ObservedAt,
+		Provider:      evt.Weather.Provider,
+		Units:         evt.Weather.Units,
 	}
 
 	c.m.EmailSentTotal.WithLabelValues(eventType).Inc()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"html/template"
 	"strconv"
+	"time"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/notification/internal/models"
 )
@@ -48,9 +49,37 @@ func (e *Service) SendConfirmation(toEmail, token string) error {
 
 func (e *Service) SendWeather(toEmail string, forecast models.WeatherData) error {
 	temp := strconv.FormatFloat(forecast.Temperature, 'f', 1, 64)
+	feelsLike := strconv.FormatFloat(forecast.FeelsLike, 'f', 1, 64)
+	wind := strconv.FormatFloat(forecast.WindSpeed, 'f', 1, 64)
+	pressure := strconv.FormatFloat(forecast.Pressure, 'f', 0, 64)
+	tempUnit, windUnit := unitSymbols(forecast.Units)
+
 	body := "Weather update for " + forecast.City + ":\n" +
-		"Temperature: " + temp + "°C\n" +
-		"Condition: " + forecast.Condition
+		"Temperature: " + temp + tempUnit + " (feels like " + feelsLike + tempUnit + ")\n" +
+		"Condition: " + forecast.Condition + "\n" +
+		"Humidity: " + strconv.Itoa(forecast.Humidity) + "%\n" +
+		"Wind: " + wind + " " + windUnit + ", " + strconv.Itoa(forecast.WindDirection) + "°\n" +
+		"Pressure: " + pressure + " hPa"
+
+	if !forecast.ObservedAt.IsZero() {
+		body += "\nObserved at: " + forecast.ObservedAt.Format(time.RFC1123)
+	}
+	if forecast.Provider != "" {
+		body += "\nSource: " + forecast.Provider
+	}
 
 	return e.emailer.Send(toEmail, "Your Daily Weather Update", "", body)
 }
+
+// unitSymbols returns the temperature and wind speed symbols for the unit
+// system the weather was reported in; weather without one is metric.
+func unitSymbols(units string) (string, string) {
+	switch units {
+	case models.UnitsImperial:
+		return "°F", "mph"
+	case models.UnitsStandard:
+		return " K", "m/s"
+	default:
+		return "°C", "m/s"
+	}
+}
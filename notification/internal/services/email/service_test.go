@@ -63,7 +63,11 @@ func TestEmailService_SendWeather(t *testing.T) {
 	}{
 		{
 			"success", nil,
-			models.WeatherData{City: "Kyiv", Temperature: 5.0, Condition: "Snow"},
+			models.WeatherData{
+				City: "Kyiv", Temperature: 5.0, Condition: "Snow",
+				Humidity: 85, WindSpeed: 6.2, WindDirection: 320, Pressure: 1021, FeelsLike: 1.4,
+				Provider: "WeatherBit",
+			},
 		},
 		{
 			"mailer error", errors.New("smtp down"),
@@ -82,7 +86,10 @@ func TestEmailService_SendWeather(t *testing.T) {
 					}
 					return strings.Contains(s, tc.forecastSend.City) &&
 						strings.Contains(s, tc.forecastSend.Condition) &&
-						strings.Contains(s, strconv.FormatFloat(tc.forecastSend.Temperature, 'f', 1, 64))
+						strings.Contains(s, strconv.FormatFloat(tc.forecastSend.Temperature, 'f', 1, 64)) &&
+						strings.Contains(s, strconv.FormatFloat(tc.forecastSend.FeelsLike, 'f', 1, 64)) &&
+						strings.Contains(s, strconv.Itoa(tc.forecastSend.Humidity)+"%") &&
+						strings.Contains(s, tc.forecastSend.Provider)
 				})).Return(tc.sendErr).Once()
 
 			t.Cleanup(func() {
@@ -101,3 +108,38 @@ func TestEmailService_SendWeather(t *testing.T) {
 func (m *mockEmailer) Body() string {
 	return ""
 }
+
+func TestEmailService_SendWeather_Units(t *testing.T) {
+	cases := []struct {
+		units string
+		want  []string
+	}{
+		{"", []string{"20.0°C (feels like 18.0°C)", "Wind: 3.0 m/s"}},
+		{models.UnitsMetric, []string{"20.0°C (feels like 18.0°C)", "Wind: 3.0 m/s"}},
+		{models.UnitsImperial, []string{"20.0°F (feels like 18.0°F)", "Wind: 3.0 mph"}},
+		{models.UnitsStandard, []string{"20.0 K (feels like 18.0 K)", "Wind: 3.0 m/s"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.units, func(t *testing.T) {
+			m := &mockEmailer{}
+			m.On("Send", "foo@bar.com", mock.Anything, mock.Anything, mock.MatchedBy(func(body string) bool {
+				for _, want := range tc.want {
+					if !strings.Contains(body, want) {
+						return false
+					}
+				}
+				return true
+			})).Return(nil).Once()
+			t.Cleanup(func() {
+				m.AssertExpectations(t)
+			})
+
+			svc := email.NewService(m, "internal/templates")
+			err := svc.SendWeather("foo@bar.com", models.WeatherData{
+				City: "Kyiv", Temperature: 20, FeelsLike: 18, WindSpeed: 3, Units: tc.units,
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
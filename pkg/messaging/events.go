@@ -1,14 +1,25 @@
 package messaging
 
+import "time"
+
 type NewSubscriptionEvent struct {
 	Email string `json:"email"`
 	Token string `json:"token"`
 }
 
 type Weather struct {
-	Temperature float64 `json:"temperature"`
-	City        string  `json:"city"`
-	Description string  `json:"description"`
+	Temperature   float64   `json:"temperature"`
+	City          string    `json:"city"`
+	Description   string    `json:"description"`
+	ConditionCode string    `json:"condition_code,omitempty"`
+	Humidity      int       `json:"humidity"`
+	WindSpeed     float64   `json:"wind_speed"`
+	WindDirection int       `json:"wind_direction"`
+	Pressure      float64   `json:"pressure"`
+	FeelsLike     float64   `json:"feels_like"`
+	ObservedAt    time.Time `json:"observed_at"`
+	Provider      string    `json:"provider"`
+	Units         string    `json:"units,omitempty"`
 }
 
 type WeatherNotifyEvent struct {
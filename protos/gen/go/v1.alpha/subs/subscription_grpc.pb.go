@@ -20,9 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	SubscriptionService_Subscribe_FullMethodName   = "/subscription.v1.alpha.SubscriptionService/Subscribe"
-	SubscriptionService_Confirm_FullMethodName     = "/subscription.v1.alpha.SubscriptionService/Confirm"
-	SubscriptionService_Unsubscribe_FullMethodName = "/subscription.v1.alpha.SubscriptionService/Unsubscribe"
+	SubscriptionService_Subscribe_FullMethodName               = "/subscription.v1.alpha.SubscriptionService/Subscribe"
+	SubscriptionService_Confirm_FullMethodName                 = "/subscription.v1.alpha.SubscriptionService/Confirm"
+	SubscriptionService_Unsubscribe_FullMethodName             = "/subscription.v1.alpha.SubscriptionService/Unsubscribe"
+	SubscriptionService_GetNotificationSchedule_FullMethodName = "/subscription.v1.alpha.SubscriptionService/GetNotificationSchedule"
 )
 
 // SubscriptionServiceClient is the client API for SubscriptionService service.
@@ -32,6 +33,10 @@ type SubscriptionServiceClient interface {
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	Confirm(ctx context.Context, in *TokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Unsubscribe(ctx context.Context, in *TokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetNotificationSchedule reports when the notifier next runs for each
+	// frequency and which cities it will need weather for. The weather service
+	// uses it to warm its cache ahead of the runs; it is not exposed over HTTP.
+	GetNotificationSchedule(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NotificationSchedule, error)
 }
 
 type subscriptionServiceClient struct {
@@ -72,6 +77,16 @@ func (c *subscriptionServiceClient) Unsubscribe(ctx context.Context, in *TokenRe
 	return out, nil
 }
 
+func (c *subscriptionServiceClient) GetNotificationSchedule(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NotificationSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationSchedule)
+	err := c.cc.Invoke(ctx, SubscriptionService_GetNotificationSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriptionServiceServer is the server API for SubscriptionService service.
 // All implementations must embed UnimplementedSubscriptionServiceServer
 // for forward compatibility
@@ -79,6 +94,10 @@ type SubscriptionServiceServer interface {
 	Subscribe(context.Context, *SubscribeRequest) (*MessageResponse, error)
 	Confirm(context.Context, *TokenRequest) (*emptypb.Empty, error)
 	Unsubscribe(context.Context, *TokenRequest) (*emptypb.Empty, error)
+	// GetNotificationSchedule reports when the notifier next runs for each
+	// frequency and which cities it will need weather for. The weather service
+	// uses it to warm its cache ahead of the runs; it is not exposed over HTTP.
+	GetNotificationSchedule(context.Context, *emptypb.Empty) (*NotificationSchedule, error)
 	mustEmbedUnimplementedSubscriptionServiceServer()
 }
 
@@ -95,6 +114,9 @@ func (UnimplementedSubscriptionServiceServer) Confirm(context.Context, *TokenReq
 func (UnimplementedSubscriptionServiceServer) Unsubscribe(context.Context, *TokenRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
 }
+func (UnimplementedSubscriptionServiceServer) GetNotificationSchedule(context.Context, *emptypb.Empty) (*NotificationSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSchedule not implemented")
+}
 func (UnimplementedSubscriptionServiceServer) mustEmbedUnimplementedSubscriptionServiceServer() {}
 
 // UnsafeSubscriptionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +184,24 @@ func _SubscriptionService_Unsubscribe_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionService_GetNotificationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).GetNotificationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_GetNotificationSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).GetNotificationSchedule(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubscriptionService_ServiceDesc is the grpc.ServiceDesc for SubscriptionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +221,10 @@ var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unsubscribe",
 			Handler:    _SubscriptionService_Unsubscribe_Handler,
 		},
+		{
+			MethodName: "GetNotificationSchedule",
+			Handler:    _SubscriptionService_GetNotificationSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1.alpha/subs/subscription.proto",
@@ -0,0 +1,1242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: v1.alpha/weather/admin.proto
+
+package weather
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProviderStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderStatusRequest) Reset() {
+	*x = ProviderStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStatusRequest) ProtoMessage() {}
+
+func (x *ProviderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStatusRequest.ProtoReflect.Descriptor instead.
+func (*ProviderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type ProviderStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State                string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // closed, half-open or open
+	Requests             uint32                 `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	TotalSuccesses       uint32                 `protobuf:"varint,4,opt,name=total_successes,json=totalSuccesses,proto3" json:"total_successes,omitempty"`
+	TotalFailures        uint32                 `protobuf:"varint,5,opt,name=total_failures,json=totalFailures,proto3" json:"total_failures,omitempty"`
+	ConsecutiveSuccesses uint32                 `protobuf:"varint,6,opt,name=consecutive_successes,json=consecutiveSuccesses,proto3" json:"consecutive_successes,omitempty"`
+	ConsecutiveFailures  uint32                 `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastError            string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_error_at,json=lastErrorAt,proto3" json:"last_error_at,omitempty"`
+	LastSuccessAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_success_at,json=lastSuccessAt,proto3" json:"last_success_at,omitempty"`
+	Priority             uint32                 `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"` // position in the fallback chain, 1 is tried first
+	Weight               float64                `protobuf:"fixed64,12,opt,name=weight,proto3" json:"weight,omitempty"`    // manual priority weight from configuration
+	Score                float64                `protobuf:"fixed64,13,opt,name=score,proto3" json:"score,omitempty"`      // weight adjusted by recent success rate and median latency
+	Samples              uint32                 `protobuf:"varint,14,opt,name=samples,proto3" json:"samples,omitempty"`   // recent calls the success rate is based on
+	SuccessRate          float64                `protobuf:"fixed64,15,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	LatencyP50Ms         int64                  `protobuf:"varint,16,opt,name=latency_p50_ms,json=latencyP50Ms,proto3" json:"latency_p50_ms,omitempty"`
+	QuotaExhausted       bool                   `protobuf:"varint,17,opt,name=quota_exhausted,json=quotaExhausted,proto3" json:"quota_exhausted,omitempty"` // call budget spent, skipped until the window ends
+}
+
+func (x *ProviderStatus) Reset() {
+	*x = ProviderStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStatus) ProtoMessage() {}
+
+func (x *ProviderStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStatus.ProtoReflect.Descriptor instead.
+func (*ProviderStatus) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProviderStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ProviderStatus) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetTotalSuccesses() uint32 {
+	if x != nil {
+		return x.TotalSuccesses
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetTotalFailures() uint32 {
+	if x != nil {
+		return x.TotalFailures
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetConsecutiveSuccesses() uint32 {
+	if x != nil {
+		return x.ConsecutiveSuccesses
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ProviderStatus) GetLastErrorAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return nil
+}
+
+func (x *ProviderStatus) GetLastSuccessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessAt
+	}
+	return nil
+}
+
+func (x *ProviderStatus) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetLatencyP50Ms() int64 {
+	if x != nil {
+		return x.LatencyP50Ms
+	}
+	return 0
+}
+
+func (x *ProviderStatus) GetQuotaExhausted() bool {
+	if x != nil {
+		return x.QuotaExhausted
+	}
+	return false
+}
+
+type ProviderStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Providers []*ProviderStatus `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *ProviderStatusResponse) Reset() {
+	*x = ProviderStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStatusResponse) ProtoMessage() {}
+
+func (x *ProviderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStatusResponse.ProtoReflect.Descriptor instead.
+func (*ProviderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProviderStatusResponse) GetProviders() []*ProviderStatus {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type ListCacheEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"` // Redis glob over cache keys, such as "weather:*"; empty lists every entry
+}
+
+func (x *ListCacheEntriesRequest) Reset() {
+	*x = ListCacheEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCacheEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheEntriesRequest) ProtoMessage() {}
+
+func (x *ListCacheEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCacheEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCacheEntriesRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type CacheEntryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Kind       string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // weather or forecast
+	City       string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"` // canonical city ID, empty for coordinate lookups
+	StoredAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=stored_at,json=storedAt,proto3" json:"stored_at,omitempty"`
+	AgeSeconds int64                  `protobuf:"varint,5,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+	TtlSeconds int64                  `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // until the cache drops the entry, 0 if it never does
+	State      string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`                              // fresh, stale or expired, see REDIS_SOFT_TTL and REDIS_HARD_TTL
+}
+
+func (x *CacheEntryInfo) Reset() {
+	*x = CacheEntryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheEntryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheEntryInfo) ProtoMessage() {}
+
+func (x *CacheEntryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheEntryInfo.ProtoReflect.Descriptor instead.
+func (*CacheEntryInfo) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CacheEntryInfo) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CacheEntryInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CacheEntryInfo) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CacheEntryInfo) GetStoredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoredAt
+	}
+	return nil
+}
+
+func (x *CacheEntryInfo) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+func (x *CacheEntryInfo) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CacheEntryInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ListCacheEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*CacheEntryInfo `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListCacheEntriesResponse) Reset() {
+	*x = ListCacheEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCacheEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheEntriesResponse) ProtoMessage() {}
+
+func (x *ListCacheEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCacheEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCacheEntriesResponse) GetEntries() []*CacheEntryInfo {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetCacheEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetCacheEntryRequest) Reset() {
+	*x = GetCacheEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCacheEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheEntryRequest) ProtoMessage() {}
+
+func (x *GetCacheEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetCacheEntryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCacheEntryRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type CacheEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info  *CacheEntryInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Value string          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // cached value as JSON
+}
+
+func (x *CacheEntry) Reset() {
+	*x = CacheEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheEntry) ProtoMessage() {}
+
+func (x *CacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheEntry.ProtoReflect.Descriptor instead.
+func (*CacheEntry) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CacheEntry) GetInfo() *CacheEntryInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *CacheEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PurgeCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City    string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *PurgeCacheRequest) Reset() {
+	*x = PurgeCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCacheRequest) ProtoMessage() {}
+
+func (x *PurgeCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCacheRequest.ProtoReflect.Descriptor instead.
+func (*PurgeCacheRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PurgeCacheRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PurgeCacheRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type PurgeCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *PurgeCacheResponse) Reset() {
+	*x = PurgeCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCacheResponse) ProtoMessage() {}
+
+func (x *PurgeCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCacheResponse.ProtoReflect.Descriptor instead.
+func (*PurgeCacheResponse) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PurgeCacheResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RefreshCityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+}
+
+func (x *RefreshCityRequest) Reset() {
+	*x = RefreshCityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_admin_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCityRequest) ProtoMessage() {}
+
+func (x *RefreshCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_admin_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCityRequest.ProtoReflect.Descriptor instead.
+func (*RefreshCityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RefreshCityRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+var File_v1_alpha_weather_admin_proto protoreflect.FileDescriptor
+
+var file_v1_alpha_weather_admin_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x76, 0x31, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x87, 0x05, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x41, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x35, 0x30, 0x5f, 0x6d, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x35,
+	0x30, 0x4d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x65, 0x78, 0x68,
+	0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22, 0x52, 0x0a, 0x16,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x33, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0xdb, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
+	0x52, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x22, 0x28, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x32, 0xb5, 0x07, 0x0a, 0x0c, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xe4, 0x06, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x06, 0x92, 0x41, 0xe4, 0x05, 0x0a,
+	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x13, 0x47, 0x65, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xfd, 0x01, 0x4c, 0x69,
+	0x73, 0x74, 0x73, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x20, 0x74, 0x72, 0x69, 0x65, 0x64, 0x2c, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73, 0x20, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x20,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x2c, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x2c, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2c, 0x20, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x20, 0x72, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20,
+	0x69, 0x73, 0x20, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x4a, 0xc5, 0x03, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0xbd, 0x03, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x22, 0xa0, 0x03, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x8b, 0x03, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x4f, 0x70, 0x65, 0x6e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x22, 0x2c, 0x20, 0x22,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x22, 0x2c, 0x20,
+	0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22,
+	0x3a, 0x20, 0x30, 0x2c, 0x20, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x22, 0x3a, 0x20, 0x30, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x35, 0x2c,
+	0x20, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
+	0x4f, 0x70, 0x65, 0x6e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x3a, 0x20, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x3a, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x35, 0x30, 0x33, 0x20, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x2c, 0x20, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x61, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x32, 0x35, 0x2d, 0x30, 0x37, 0x2d, 0x30, 0x31,
+	0x54, 0x31, 0x32, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x2c, 0x20, 0x22, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x33, 0x2c, 0x20, 0x22, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x3a, 0x20, 0x30, 0x2c, 0x20, 0x22, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20,
+	0x34, 0x30, 0x2c, 0x20, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x22, 0x3a, 0x20, 0x30, 0x2e, 0x35, 0x2c, 0x20, 0x22, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x70, 0x35, 0x30, 0x5f, 0x6d, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x34, 0x32, 0x30, 0x22,
+	0x7d, 0x5d, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x1a, 0x3e, 0x92, 0x41, 0x3b, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x32,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x76, 0x69, 0x65, 0x77,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x73, 0x2e, 0x32, 0xba, 0x11, 0x0a, 0x11, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xf4, 0x04, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x23, 0x2e,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x04, 0x92, 0x41, 0xf5, 0x03, 0x0a,
+	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x7d, 0x4c, 0x69, 0x73, 0x74,
+	0x73, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x66, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x6b, 0x65, 0x79,
+	0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x61, 0x20, 0x52, 0x65, 0x64, 0x69, 0x73, 0x20,
+	0x67, 0x6c, 0x6f, 0x62, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2c, 0x20, 0x77, 0x69,
+	0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x54, 0x54, 0x4c, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x4a, 0xfb, 0x01, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0xf3, 0x01, 0x0a, 0x26, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2c, 0x20, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x6b, 0x65, 0x79, 0x22, 0xc8, 0x01, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0xb3, 0x01, 0x7b, 0x22, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x20,
+	0x5b, 0x7b, 0x22, 0x6b, 0x65, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x3a, 0x6c, 0x76, 0x69, 0x76, 0x2d, 0x75, 0x61, 0x22, 0x2c, 0x20, 0x22, 0x6b, 0x69, 0x6e,
+	0x64, 0x22, 0x3a, 0x20, 0x22, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x22, 0x2c, 0x20, 0x22,
+	0x63, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x6c, 0x76, 0x69, 0x76, 0x2d, 0x75, 0x61, 0x22,
+	0x2c, 0x20, 0x22, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x22,
+	0x32, 0x30, 0x32, 0x35, 0x2d, 0x30, 0x37, 0x2d, 0x30, 0x31, 0x54, 0x31, 0x32, 0x3a, 0x30, 0x30,
+	0x3a, 0x30, 0x30, 0x5a, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x34, 0x32, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x74,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x33, 0x31, 0x38,
+	0x30, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x74,
+	0x61, 0x6c, 0x65, 0x22, 0x7d, 0x5d, 0x7d, 0x4a, 0x5b, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x54,
+	0x0a, 0x1c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x72, 0x20, 0x77, 0x72, 0x6f,
+	0x6e, 0x67, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x12, 0x20, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12,
+	0xa6, 0x02, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xda, 0x01, 0x92, 0x41,
+	0xb5, 0x01, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x15, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x20, 0x61, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x1a, 0x3a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x20, 0x61, 0x73, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x4a, 0x59, 0x0a, 0x03,
+	0x34, 0x30, 0x34, 0x12, 0x52, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x3d, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x7b, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x6e, 0x6f, 0x74, 0x20, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x3a, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x3a, 0x61, 0x74, 0x6c,
+	0x61, 0x6e, 0x74, 0x69, 0x73, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0xe7, 0x04, 0x0a, 0x0a, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x04, 0x92, 0x41, 0xf1, 0x03, 0x0a, 0x05, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x20, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0xe5, 0x01, 0x44, 0x72, 0x6f, 0x70,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x63, 0x69, 0x74, 0x79, 0x2c, 0x20, 0x6f,
+	0x72, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x77, 0x68,
+	0x6f, 0x73, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20,
+	0x61, 0x20, 0x52, 0x65, 0x64, 0x69, 0x73, 0x20, 0x67, 0x6c, 0x6f, 0x62, 0x20, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x2e, 0x20, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x2e, 0x20, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x69,
+	0x72, 0x20, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x20, 0x63, 0x6f, 0x70,
+	0x79, 0x20, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x20, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x43, 0x41,
+	0x43, 0x48, 0x45, 0x5f, 0x54, 0x54, 0x4c, 0x20, 0x72, 0x75, 0x6e, 0x73, 0x20, 0x6f, 0x75, 0x74,
+	0x2e, 0x4a, 0x68, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x61, 0x0a, 0x16, 0x4b, 0x65, 0x79, 0x73,
+	0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x22, 0x47, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x33, 0x7b, 0x22, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x3a, 0x6c, 0x76, 0x69, 0x76,
+	0x2d, 0x75, 0x61, 0x3a, 0x33, 0x22, 0x2c, 0x20, 0x22, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x3a, 0x6c, 0x76, 0x69, 0x76, 0x2d, 0x75, 0x61, 0x22, 0x5d, 0x7d, 0x4a, 0x80, 0x01, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x79, 0x0a, 0x29, 0x4e, 0x65, 0x69, 0x74, 0x68, 0x65, 0x72, 0x20, 0x6f,
+	0x72, 0x20, 0x62, 0x6f, 0x74, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e,
+	0x22, 0x4c, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x63, 0x69, 0x74, 0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x12, 0x83, 0x04, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x69,
+	0x74, 0x79, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xbb, 0x03, 0x92, 0x41, 0x91,
+	0x03, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x14, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x2d,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x61, 0x20, 0x63, 0x69, 0x74, 0x79, 0x1a, 0x9a,
+	0x01, 0x52, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x20, 0x77, 0x68, 0x61, 0x74,
+	0x65, 0x76, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20,
+	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x65,
+	0x79, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20,
+	0x6f, 0x6e, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x75, 0x73, 0x65, 0x4a, 0xd4, 0x01, 0x0a, 0x03,
+	0x35, 0x30, 0x33, 0x12, 0xcc, 0x01, 0x0a, 0x4e, 0x4e, 0x6f, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x3b, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x20, 0x69, 0x73, 0x20, 0x6c, 0x65, 0x66, 0x74, 0x20, 0x61, 0x73, 0x20,
+	0x69, 0x74, 0x20, 0x77, 0x61, 0x73, 0x22, 0x7a, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x66, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x6c, 0x6c, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x3a, 0x20, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x50,
+	0x49, 0x3a, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x75, 0x6e, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x20, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x20, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x70, 0x65, 0x6e,
+	0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x1a, 0x94, 0x01, 0x92, 0x41, 0x90, 0x01, 0x0a,
+	0x0b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x80, 0x01, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x20, 0x45, 0x76, 0x65, 0x72, 0x79, 0x20, 0x63, 0x61, 0x6c,
+	0x6c, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x22, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3e, 0x22, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48,
+	0x45, 0x52, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x2e, 0x42,
+	0x5a, 0x5a, 0x58, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x61,
+	0x7a, 0x61, 0x72, 0x69, 0x6f, 0x75, 0x73, 0x2d, 0x75, 0x63, 0x75, 0x2f, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x2d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x67, 0x6f, 0x2f, 0x76, 0x31, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2f, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x3b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_alpha_weather_admin_proto_rawDescOnce sync.Once
+	file_v1_alpha_weather_admin_proto_rawDescData = file_v1_alpha_weather_admin_proto_rawDesc
+)
+
+func file_v1_alpha_weather_admin_proto_rawDescGZIP() []byte {
+	file_v1_alpha_weather_admin_proto_rawDescOnce.Do(func() {
+		file_v1_alpha_weather_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_alpha_weather_admin_proto_rawDescData)
+	})
+	return file_v1_alpha_weather_admin_proto_rawDescData
+}
+
+var file_v1_alpha_weather_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_v1_alpha_weather_admin_proto_goTypes = []any{
+	(*ProviderStatusRequest)(nil),    // 0: weather.v1.ProviderStatusRequest
+	(*ProviderStatus)(nil),           // 1: weather.v1.ProviderStatus
+	(*ProviderStatusResponse)(nil),   // 2: weather.v1.ProviderStatusResponse
+	(*ListCacheEntriesRequest)(nil),  // 3: weather.v1.ListCacheEntriesRequest
+	(*CacheEntryInfo)(nil),           // 4: weather.v1.CacheEntryInfo
+	(*ListCacheEntriesResponse)(nil), // 5: weather.v1.ListCacheEntriesResponse
+	(*GetCacheEntryRequest)(nil),     // 6: weather.v1.GetCacheEntryRequest
+	(*CacheEntry)(nil),               // 7: weather.v1.CacheEntry
+	(*PurgeCacheRequest)(nil),        // 8: weather.v1.PurgeCacheRequest
+	(*PurgeCacheResponse)(nil),       // 9: weather.v1.PurgeCacheResponse
+	(*RefreshCityRequest)(nil),       // 10: weather.v1.RefreshCityRequest
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+}
+var file_v1_alpha_weather_admin_proto_depIdxs = []int32{
+	11, // 0: weather.v1.ProviderStatus.last_error_at:type_name -> google.protobuf.Timestamp
+	11, // 1: weather.v1.ProviderStatus.last_success_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: weather.v1.ProviderStatusResponse.providers:type_name -> weather.v1.ProviderStatus
+	11, // 3: weather.v1.CacheEntryInfo.stored_at:type_name -> google.protobuf.Timestamp
+	4,  // 4: weather.v1.ListCacheEntriesResponse.entries:type_name -> weather.v1.CacheEntryInfo
+	4,  // 5: weather.v1.CacheEntry.info:type_name -> weather.v1.CacheEntryInfo
+	0,  // 6: weather.v1.AdminService.GetProviderStatus:input_type -> weather.v1.ProviderStatusRequest
+	3,  // 7: weather.v1.CacheAdminService.ListCacheEntries:input_type -> weather.v1.ListCacheEntriesRequest
+	6,  // 8: weather.v1.CacheAdminService.GetCacheEntry:input_type -> weather.v1.GetCacheEntryRequest
+	8,  // 9: weather.v1.CacheAdminService.PurgeCache:input_type -> weather.v1.PurgeCacheRequest
+	10, // 10: weather.v1.CacheAdminService.RefreshCity:input_type -> weather.v1.RefreshCityRequest
+	2,  // 11: weather.v1.AdminService.GetProviderStatus:output_type -> weather.v1.ProviderStatusResponse
+	5,  // 12: weather.v1.CacheAdminService.ListCacheEntries:output_type -> weather.v1.ListCacheEntriesResponse
+	7,  // 13: weather.v1.CacheAdminService.GetCacheEntry:output_type -> weather.v1.CacheEntry
+	9,  // 14: weather.v1.CacheAdminService.PurgeCache:output_type -> weather.v1.PurgeCacheResponse
+	7,  // 15: weather.v1.CacheAdminService.RefreshCity:output_type -> weather.v1.CacheEntry
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_v1_alpha_weather_admin_proto_init() }
+func file_v1_alpha_weather_admin_proto_init() {
+	if File_v1_alpha_weather_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1_alpha_weather_admin_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ProviderStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ProviderStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ProviderStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCacheEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CacheEntryInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCacheEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCacheEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CacheEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeCacheResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_admin_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshCityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_alpha_weather_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_v1_alpha_weather_admin_proto_goTypes,
+		DependencyIndexes: file_v1_alpha_weather_admin_proto_depIdxs,
+		MessageInfos:      file_v1_alpha_weather_admin_proto_msgTypes,
+	}.Build()
+	File_v1_alpha_weather_admin_proto = out.File
+	file_v1_alpha_weather_admin_proto_rawDesc = nil
+	file_v1_alpha_weather_admin_proto_goTypes = nil
+	file_v1_alpha_weather_admin_proto_depIdxs = nil
+}
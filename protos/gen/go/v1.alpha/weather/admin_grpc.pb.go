@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: v1.alpha/weather/admin.proto
+
+package weather
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	AdminService_GetProviderStatus_FullMethodName = "/weather.v1.AdminService/GetProviderStatus"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	GetProviderStatus(ctx context.Context, in *ProviderStatusRequest, opts ...grpc.CallOption) (*ProviderStatusResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetProviderStatus(ctx context.Context, in *ProviderStatusRequest, opts ...grpc.CallOption) (*ProviderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetProviderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	GetProviderStatus(context.Context, *ProviderStatusRequest) (*ProviderStatusResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) GetProviderStatus(context.Context, *ProviderStatusRequest) (*ProviderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderStatus not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_GetProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetProviderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetProviderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetProviderStatus(ctx, req.(*ProviderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "weather.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProviderStatus",
+			Handler:    _AdminService_GetProviderStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1.alpha/weather/admin.proto",
+}
+
+const (
+	CacheAdminService_ListCacheEntries_FullMethodName = "/weather.v1.CacheAdminService/ListCacheEntries"
+	CacheAdminService_GetCacheEntry_FullMethodName    = "/weather.v1.CacheAdminService/GetCacheEntry"
+	CacheAdminService_PurgeCache_FullMethodName       = "/weather.v1.CacheAdminService/PurgeCache"
+	CacheAdminService_RefreshCity_FullMethodName      = "/weather.v1.CacheAdminService/RefreshCity"
+)
+
+// CacheAdminServiceClient is the client API for CacheAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CacheAdminServiceClient interface {
+	ListCacheEntries(ctx context.Context, in *ListCacheEntriesRequest, opts ...grpc.CallOption) (*ListCacheEntriesResponse, error)
+	GetCacheEntry(ctx context.Context, in *GetCacheEntryRequest, opts ...grpc.CallOption) (*CacheEntry, error)
+	PurgeCache(ctx context.Context, in *PurgeCacheRequest, opts ...grpc.CallOption) (*PurgeCacheResponse, error)
+	RefreshCity(ctx context.Context, in *RefreshCityRequest, opts ...grpc.CallOption) (*CacheEntry, error)
+}
+
+type cacheAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCacheAdminServiceClient(cc grpc.ClientConnInterface) CacheAdminServiceClient {
+	return &cacheAdminServiceClient{cc}
+}
+
+func (c *cacheAdminServiceClient) ListCacheEntries(ctx context.Context, in *ListCacheEntriesRequest, opts ...grpc.CallOption) (*ListCacheEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCacheEntriesResponse)
+	err := c.cc.Invoke(ctx, CacheAdminService_ListCacheEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheAdminServiceClient) GetCacheEntry(ctx context.Context, in *GetCacheEntryRequest, opts ...grpc.CallOption) (*CacheEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheEntry)
+	err := c.cc.Invoke(ctx, CacheAdminService_GetCacheEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheAdminServiceClient) PurgeCache(ctx context.Context, in *PurgeCacheRequest, opts ...grpc.CallOption) (*PurgeCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeCacheResponse)
+	err := c.cc.Invoke(ctx, CacheAdminService_PurgeCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheAdminServiceClient) RefreshCity(ctx context.Context, in *RefreshCityRequest, opts ...grpc.CallOption) (*CacheEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheEntry)
+	err := c.cc.Invoke(ctx, CacheAdminService_RefreshCity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CacheAdminServiceServer is the server API for CacheAdminService service.
+// All implementations must embed UnimplementedCacheAdminServiceServer
+// for forward compatibility
+type CacheAdminServiceServer interface {
+	ListCacheEntries(context.Context, *ListCacheEntriesRequest) (*ListCacheEntriesResponse, error)
+	GetCacheEntry(context.Context, *GetCacheEntryRequest) (*CacheEntry, error)
+	PurgeCache(context.Context, *PurgeCacheRequest) (*PurgeCacheResponse, error)
+	RefreshCity(context.Context, *RefreshCityRequest) (*CacheEntry, error)
+	mustEmbedUnimplementedCacheAdminServiceServer()
+}
+
+// UnimplementedCacheAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCacheAdminServiceServer struct {
+}
+
+func (UnimplementedCacheAdminServiceServer) ListCacheEntries(context.Context, *ListCacheEntriesRequest) (*ListCacheEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCacheEntries not implemented")
+}
+func (UnimplementedCacheAdminServiceServer) GetCacheEntry(context.Context, *GetCacheEntryRequest) (*CacheEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheEntry not implemented")
+}
+func (UnimplementedCacheAdminServiceServer) PurgeCache(context.Context, *PurgeCacheRequest) (*PurgeCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCache not implemented")
+}
+func (UnimplementedCacheAdminServiceServer) RefreshCity(context.Context, *RefreshCityRequest) (*CacheEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCity not implemented")
+}
+func (UnimplementedCacheAdminServiceServer) mustEmbedUnimplementedCacheAdminServiceServer() {}
+
+// UnsafeCacheAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CacheAdminServiceServer will
+// result in compilation errors.
+type UnsafeCacheAdminServiceServer interface {
+	mustEmbedUnimplementedCacheAdminServiceServer()
+}
+
+func RegisterCacheAdminServiceServer(s grpc.ServiceRegistrar, srv CacheAdminServiceServer) {
+	s.RegisterService(&CacheAdminService_ServiceDesc, srv)
+}
+
+func _CacheAdminService_ListCacheEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCacheEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServiceServer).ListCacheEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdminService_ListCacheEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServiceServer).ListCacheEntries(ctx, req.(*ListCacheEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheAdminService_GetCacheEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServiceServer).GetCacheEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdminService_GetCacheEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServiceServer).GetCacheEntry(ctx, req.(*GetCacheEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheAdminService_PurgeCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServiceServer).PurgeCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdminService_PurgeCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServiceServer).PurgeCache(ctx, req.(*PurgeCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheAdminService_RefreshCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServiceServer).RefreshCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdminService_RefreshCity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServiceServer).RefreshCity(ctx, req.(*RefreshCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CacheAdminService_ServiceDesc is the grpc.ServiceDesc for CacheAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CacheAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "weather.v1.CacheAdminService",
+	HandlerType: (*CacheAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCacheEntries",
+			Handler:    _CacheAdminService_ListCacheEntries_Handler,
+		},
+		{
+			MethodName: "GetCacheEntry",
+			Handler:    _CacheAdminService_GetCacheEntry_Handler,
+		},
+		{
+			MethodName: "PurgeCache",
+			Handler:    _CacheAdminService_PurgeCache_Handler,
+		},
+		{
+			MethodName: "RefreshCity",
+			Handler:    _CacheAdminService_RefreshCity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1.alpha/weather/admin.proto",
+}
@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -22,31 +23,966 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Condition is a provider-independent weather condition, mapped from each
+// provider's own condition codes.
+type Condition int32
+
+const (
+	Condition_CONDITION_UNSPECIFIED   Condition = 0 // the provider's condition has no mapping
+	Condition_CONDITION_CLEAR         Condition = 1
+	Condition_CONDITION_PARTLY_CLOUDY Condition = 2
+	Condition_CONDITION_CLOUDY        Condition = 3
+	Condition_CONDITION_FOG           Condition = 4 // fog, mist, haze and other low visibility
+	Condition_CONDITION_DRIZZLE       Condition = 5
+	Condition_CONDITION_RAIN          Condition = 6
+	Condition_CONDITION_SLEET         Condition = 7 // sleet, freezing rain and ice pellets
+	Condition_CONDITION_SNOW          Condition = 8
+	Condition_CONDITION_THUNDERSTORM  Condition = 9
+)
+
+// Enum value maps for Condition.
+var (
+	Condition_name = map[int32]string{
+		0: "CONDITION_UNSPECIFIED",
+		1: "CONDITION_CLEAR",
+		2: "CONDITION_PARTLY_CLOUDY",
+		3: "CONDITION_CLOUDY",
+		4: "CONDITION_FOG",
+		5: "CONDITION_DRIZZLE",
+		6: "CONDITION_RAIN",
+		7: "CONDITION_SLEET",
+		8: "CONDITION_SNOW",
+		9: "CONDITION_THUNDERSTORM",
+	}
+	Condition_value = map[string]int32{
+		"CONDITION_UNSPECIFIED":   0,
+		"CONDITION_CLEAR":         1,
+		"CONDITION_PARTLY_CLOUDY": 2,
+		"CONDITION_CLOUDY":        3,
+		"CONDITION_FOG":           4,
+		"CONDITION_DRIZZLE":       5,
+		"CONDITION_RAIN":          6,
+		"CONDITION_SLEET":         7,
+		"CONDITION_SNOW":          8,
+		"CONDITION_THUNDERSTORM":  9,
+	}
+)
+
+func (x Condition) Enum() *Condition {
+	p := new(Condition)
+	*p = x
+	return p
+}
+
+func (x Condition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Condition) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_alpha_weather_weather_proto_enumTypes[0].Descriptor()
+}
+
+func (Condition) Type() protoreflect.EnumType {
+	return &file_v1_alpha_weather_weather_proto_enumTypes[0]
+}
+
+func (x Condition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Condition.Descriptor instead.
+func (Condition) EnumDescriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{0}
+}
+
 type WeatherRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	City string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	City  string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Units string `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"` // "metric" (default, °C and m/s), "imperial" (°F and mph) or "standard" (K and m/s); pressure is always hPa
+	Lang  string `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`   // condition text language, such as "uk" or "pt_br"; defaults to English
+}
+
+func (x *WeatherRequest) Reset() {
+	*x = WeatherRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeatherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherRequest) ProtoMessage() {}
+
+func (x *WeatherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherRequest.ProtoReflect.Descriptor instead.
+func (*WeatherRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeatherRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *WeatherRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *WeatherRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+type WeatherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City              string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Temperature       float64                `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Condition         string                 `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`                               // the provider's own text, in the requested language
+	Humidity          int32                  `protobuf:"varint,4,opt,name=humidity,proto3" json:"humidity,omitempty"`                                // percent
+	WindSpeed         float64                `protobuf:"fixed64,5,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"`            // m/s
+	WindDirection     int32                  `protobuf:"varint,6,opt,name=wind_direction,json=windDirection,proto3" json:"wind_direction,omitempty"` // degrees, meteorological
+	Pressure          float64                `protobuf:"fixed64,7,opt,name=pressure,proto3" json:"pressure,omitempty"`                               // hPa
+	FeelsLike         float64                `protobuf:"fixed64,8,opt,name=feels_like,json=feelsLike,proto3" json:"feels_like,omitempty"`
+	ObservedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
+	Provider          string                 `protobuf:"bytes,10,opt,name=provider,proto3" json:"provider,omitempty"`
+	Contributors      []string               `protobuf:"bytes,11,rep,name=contributors,proto3" json:"contributors,omitempty"`                                      // aggregate strategy only
+	TemperatureSpread float64                `protobuf:"fixed64,12,opt,name=temperature_spread,json=temperatureSpread,proto3" json:"temperature_spread,omitempty"` // aggregate strategy only, max - min across contributors
+	Stale             bool                   `protobuf:"varint,13,opt,name=stale,proto3" json:"stale,omitempty"`                                                   // served from cache past its soft TTL, e.g. while every provider is failing
+	Units             string                 `protobuf:"bytes,14,opt,name=units,proto3" json:"units,omitempty"`                                                    // unit system of the values; empty means metric
+	ConditionCode     Condition              `protobuf:"varint,15,opt,name=condition_code,json=conditionCode,proto3,enum=weather.v1.Condition" json:"condition_code,omitempty"`
+}
+
+func (x *WeatherResponse) Reset() {
+	*x = WeatherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeatherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherResponse) ProtoMessage() {}
+
+func (x *WeatherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherResponse.ProtoReflect.Descriptor instead.
+func (*WeatherResponse) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WeatherResponse) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *WeatherResponse) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *WeatherResponse) GetHumidity() int32 {
+	if x != nil {
+		return x.Humidity
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetWindSpeed() float64 {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetWindDirection() int32 {
+	if x != nil {
+		return x.WindDirection
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetPressure() float64 {
+	if x != nil {
+		return x.Pressure
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetFeelsLike() float64 {
+	if x != nil {
+		return x.FeelsLike
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetObservedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return nil
+}
+
+func (x *WeatherResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *WeatherResponse) GetContributors() []string {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+func (x *WeatherResponse) GetTemperatureSpread() float64 {
+	if x != nil {
+		return x.TemperatureSpread
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *WeatherResponse) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *WeatherResponse) GetConditionCode() Condition {
+	if x != nil {
+		return x.ConditionCode
+	}
+	return Condition_CONDITION_UNSPECIFIED
+}
+
+type CoordinatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"` // -90..90
+	Lon float64 `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"` // -180..180
+}
+
+func (x *CoordinatesRequest) Reset() {
+	*x = CoordinatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoordinatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoordinatesRequest) ProtoMessage() {}
+
+func (x *CoordinatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoordinatesRequest.ProtoReflect.Descriptor instead.
+func (*CoordinatesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CoordinatesRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *CoordinatesRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+type ForecastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Days int32  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // 1..5, defaults to 3 when omitted
+}
+
+func (x *ForecastRequest) Reset() {
+	*x = ForecastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastRequest) ProtoMessage() {}
+
+func (x *ForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastRequest.ProtoReflect.Descriptor instead.
+func (*ForecastRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForecastRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ForecastRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type DailyForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date                string    `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD, provider local date
+	MinTemperature      float64   `protobuf:"fixed64,2,opt,name=min_temperature,json=minTemperature,proto3" json:"min_temperature,omitempty"`
+	MaxTemperature      float64   `protobuf:"fixed64,3,opt,name=max_temperature,json=maxTemperature,proto3" json:"max_temperature,omitempty"`
+	Condition           string    `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`                                                  // the provider's own text
+	PrecipitationChance float64   `protobuf:"fixed64,5,opt,name=precipitation_chance,json=precipitationChance,proto3" json:"precipitation_chance,omitempty"` // percent, 0..100
+	PrecipitationMm     float64   `protobuf:"fixed64,6,opt,name=precipitation_mm,json=precipitationMm,proto3" json:"precipitation_mm,omitempty"`
+	ConditionCode       Condition `protobuf:"varint,7,opt,name=condition_code,json=conditionCode,proto3,enum=weather.v1.Condition" json:"condition_code,omitempty"`
+}
+
+func (x *DailyForecast) Reset() {
+	*x = DailyForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyForecast) ProtoMessage() {}
+
+func (x *DailyForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyForecast.ProtoReflect.Descriptor instead.
+func (*DailyForecast) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DailyForecast) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyForecast) GetMinTemperature() float64 {
+	if x != nil {
+		return x.MinTemperature
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetMaxTemperature() float64 {
+	if x != nil {
+		return x.MaxTemperature
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *DailyForecast) GetPrecipitationChance() float64 {
+	if x != nil {
+		return x.PrecipitationChance
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetPrecipitationMm() float64 {
+	if x != nil {
+		return x.PrecipitationMm
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetConditionCode() Condition {
+	if x != nil {
+		return x.ConditionCode
+	}
+	return Condition_CONDITION_UNSPECIFIED
+}
+
+type ForecastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City  string           `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Days  []*DailyForecast `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	Stale bool             `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"` // served from cache past its soft TTL
+}
+
+func (x *ForecastResponse) Reset() {
+	*x = ForecastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastResponse) ProtoMessage() {}
+
+func (x *ForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastResponse.ProtoReflect.Descriptor instead.
+func (*ForecastResponse) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ForecastResponse) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ForecastResponse) GetDays() []*DailyForecast {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *ForecastResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City                 string  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	TemperatureThreshold float64 `protobuf:"fixed64,2,opt,name=temperature_threshold,json=temperatureThreshold,proto3" json:"temperature_threshold,omitempty"` // degrees; 0 sends every refresh
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *WatchRequest) GetTemperatureThreshold() float64 {
+	if x != nil {
+		return x.TemperatureThreshold
+	}
+	return 0
+}
+
+type CitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cities []string `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	Units  string   `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"` // as in WeatherRequest
+	Lang   string   `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`   // as in WeatherRequest
+}
+
+func (x *CitiesRequest) Reset() {
+	*x = CitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitiesRequest) ProtoMessage() {}
+
+func (x *CitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitiesRequest.ProtoReflect.Descriptor instead.
+func (*CitiesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CitiesRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *CitiesRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *CitiesRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+type CitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weather map[string]*WeatherResponse `protobuf:"bytes,1,rep,name=weather,proto3" json:"weather,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // keyed by requested city
+	Errors  map[string]string           `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`   // requested city -> failure reason
+}
+
+func (x *CitiesResponse) Reset() {
+	*x = CitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitiesResponse) ProtoMessage() {}
+
+func (x *CitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitiesResponse.ProtoReflect.Descriptor instead.
+func (*CitiesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CitiesResponse) GetWeather() map[string]*WeatherResponse {
+	if x != nil {
+		return x.Weather
+	}
+	return nil
+}
+
+func (x *CitiesResponse) GetErrors() map[string]string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type SearchCitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 10, at most 50
+}
+
+func (x *SearchCitiesRequest) Reset() {
+	*x = SearchCitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCitiesRequest) ProtoMessage() {}
+
+func (x *SearchCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCitiesRequest.ProtoReflect.Descriptor instead.
+func (*SearchCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchCitiesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SearchCitiesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type City struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Country    string  `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166-1 alpha-2
+	Latitude   float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude  float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Timezone   string  `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name
+	Population int64   `protobuf:"varint,7,opt,name=population,proto3" json:"population,omitempty"`
+}
+
+func (x *City) Reset() {
+	*x = City{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *City) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*City) ProtoMessage() {}
+
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *City) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *City) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *City) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *City) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *City) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *City) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *City) GetPopulation() int64 {
+	if x != nil {
+		return x.Population
+	}
+	return 0
+}
+
+type SearchCitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cities []*City `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+}
+
+func (x *SearchCitiesResponse) Reset() {
+	*x = SearchCitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCitiesResponse) ProtoMessage() {}
+
+func (x *SearchCitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCitiesResponse.ProtoReflect.Descriptor instead.
+func (*SearchCitiesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchCitiesResponse) GetCities() []*City {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type HistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City        string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	From        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`               // defaults to 24 hours before to
+	To          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                   // exclusive, defaults to now
+	Granularity string                 `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"` // "raw", "hour" or "day", defaults to "hour"; raw ranges span at most 7 days, others 366
 }
 
-func (x *WeatherRequest) Reset() {
-	*x = WeatherRequest{}
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_alpha_weather_weather_proto_msgTypes[0]
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WeatherRequest) String() string {
+func (x *HistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WeatherRequest) ProtoMessage() {}
+func (*HistoryRequest) ProtoMessage() {}
 
-func (x *WeatherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_alpha_weather_weather_proto_msgTypes[0]
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,45 +993,74 @@ func (x *WeatherRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WeatherRequest.ProtoReflect.Descriptor instead.
-func (*WeatherRequest) Descriptor() ([]byte, []int) {
-	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *WeatherRequest) GetCity() string {
+func (x *HistoryRequest) GetCity() string {
 	if x != nil {
 		return x.City
 	}
 	return ""
 }
 
-type WeatherResponse struct {
+func (x *HistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *HistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *HistoryRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+type HistoryPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	City        string  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
-	Temperature float64 `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
-	Condition   string  `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Time           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                 // observation time, or start of the bucket
+	Temperature    float64                `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"` // average over the bucket
+	MinTemperature float64                `protobuf:"fixed64,3,opt,name=min_temperature,json=minTemperature,proto3" json:"min_temperature,omitempty"`
+	MaxTemperature float64                `protobuf:"fixed64,4,opt,name=max_temperature,json=maxTemperature,proto3" json:"max_temperature,omitempty"`
+	FeelsLike      float64                `protobuf:"fixed64,5,opt,name=feels_like,json=feelsLike,proto3" json:"feels_like,omitempty"`
+	Humidity       float64                `protobuf:"fixed64,6,opt,name=humidity,proto3" json:"humidity,omitempty"`                    // percent
+	WindSpeed      float64                `protobuf:"fixed64,7,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"` // m/s
+	Pressure       float64                `protobuf:"fixed64,8,opt,name=pressure,proto3" json:"pressure,omitempty"`                    // hPa
+	Samples        int32                  `protobuf:"varint,9,opt,name=samples,proto3" json:"samples,omitempty"`                       // observations in the bucket
+	Condition      string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`                   // raw only
+	Provider       string                 `protobuf:"bytes,11,opt,name=provider,proto3" json:"provider,omitempty"`                     // raw only
 }
 
-func (x *WeatherResponse) Reset() {
-	*x = WeatherResponse{}
+func (x *HistoryPoint) Reset() {
+	*x = HistoryPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_alpha_weather_weather_proto_msgTypes[1]
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WeatherResponse) String() string {
+func (x *HistoryPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WeatherResponse) ProtoMessage() {}
+func (*HistoryPoint) ProtoMessage() {}
 
-func (x *WeatherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_alpha_weather_weather_proto_msgTypes[1]
+func (x *HistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,32 +1071,151 @@ func (x *WeatherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WeatherResponse.ProtoReflect.Descriptor instead.
-func (*WeatherResponse) Descriptor() ([]byte, []int) {
-	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use HistoryPoint.ProtoReflect.Descriptor instead.
+func (*HistoryPoint) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *WeatherResponse) GetCity() string {
+func (x *HistoryPoint) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.City
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *WeatherResponse) GetTemperature() float64 {
+func (x *HistoryPoint) GetTemperature() float64 {
 	if x != nil {
 		return x.Temperature
 	}
 	return 0
 }
 
-func (x *WeatherResponse) GetCondition() string {
+func (x *HistoryPoint) GetMinTemperature() float64 {
+	if x != nil {
+		return x.MinTemperature
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetMaxTemperature() float64 {
+	if x != nil {
+		return x.MaxTemperature
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetFeelsLike() float64 {
+	if x != nil {
+		return x.FeelsLike
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetHumidity() float64 {
+	if x != nil {
+		return x.Humidity
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetWindSpeed() float64 {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetPressure() float64 {
+	if x != nil {
+		return x.Pressure
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetCondition() string {
 	if x != nil {
 		return x.Condition
 	}
 	return ""
 }
 
+func (x *HistoryPoint) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type HistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City        string          `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Granularity string          `protobuf:"bytes,2,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Points      []*HistoryPoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_alpha_weather_weather_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryResponse) ProtoMessage() {}
+
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_alpha_weather_weather_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_alpha_weather_weather_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HistoryResponse) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *HistoryResponse) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *HistoryResponse) GetPoints() []*HistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
 var File_v1_alpha_weather_weather_proto protoreflect.FileDescriptor
 
 var file_v1_alpha_weather_weather_proto_rawDesc = []byte{
@@ -139,66 +1223,592 @@ var file_v1_alpha_weather_weather_proto_rawDesc = []byte{
 	0x65, 0x72, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0a, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32,
-	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x24, 0x0a, 0x0e, 0x57, 0x65,
-	0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79,
-	0x22, 0x65, 0x0a, 0x0f, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65,
-	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x89, 0x05, 0x0a, 0x0e, 0x57, 0x65, 0x61, 0x74,
-	0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb4, 0x04, 0x0a, 0x09, 0x47,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x0e, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x22, 0x98, 0x04, 0x0a, 0x0f,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x4c, 0x69, 0x6b, 0x65,
+	0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x38, 0x0a, 0x12, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x6e,
+	0x22, 0x39, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x0d,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x13, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6d, 0x12,
+	0x3c, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x6b, 0x0a,
+	0x10, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x22, 0x57, 0x0a, 0x0c, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x33,
+	0x0a, 0x15, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x51, 0x0a, 0x0d, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x22, 0xa7, 0x02, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x57, 0x0a, 0x0c,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x43, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x06, 0x63, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72,
+	0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0xfc, 0x02, 0x0a, 0x0c, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e,
+	0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x77,
+	0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x79, 0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x2a, 0xf1, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f,
+	0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x4c, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x59, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x55,
+	0x44, 0x59, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x4f, 0x47, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x44, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x52, 0x49, 0x5a, 0x5a, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x12,
+	0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x41, 0x49, 0x4e,
+	0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x4c, 0x45, 0x45, 0x54, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x44, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x10, 0x08, 0x12, 0x1a, 0x0a, 0x16, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x48, 0x55, 0x4e, 0x44, 0x45, 0x52,
+	0x53, 0x54, 0x4f, 0x52, 0x4d, 0x10, 0x09, 0x32, 0xaf, 0x31, 0x0a, 0x0e, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc9, 0x09, 0x0a, 0x09, 0x47,
 	0x65, 0x74, 0x42, 0x79, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68,
 	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76,
 	0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xed, 0x03, 0x92, 0x41, 0xd2, 0x03, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x65, 0x22, 0x82, 0x09, 0x92, 0x41, 0xe7, 0x08, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
 	0x72, 0x12, 0x13, 0x47, 0x65, 0x74, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77,
-	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x1a, 0x2c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x1a, 0x7b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
 	0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x65, 0x61, 0x74,
 	0x68, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20,
-	0x63, 0x69, 0x74, 0x79, 0x4a, 0x7d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x76, 0x0a, 0x23, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x72, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x65, 0x64, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x4f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x7b, 0x22, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3a,
-	0x20, 0x22, 0x4c, 0x76, 0x69, 0x76, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x31, 0x2e, 0x35, 0x2c, 0x20, 0x22, 0x63,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x75, 0x6e, 0x6e,
-	0x79, 0x22, 0x7d, 0x4a, 0x68, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x61, 0x0a, 0x1c, 0x4d, 0x69,
-	0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2d,
-	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x69, 0x74, 0x79, 0x20,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x20,
-	0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x48, 0x0a,
-	0x03, 0x34, 0x30, 0x34, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x79, 0x20, 0x6e, 0x6f, 0x74,
-	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x74, 0x79, 0x20, 0x6e, 0x6f, 0x74, 0x20,
-	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x7d, 0x4a, 0x51, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x4a,
-	0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x7b, 0x22, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
-	0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
-	0x72, 0x1a, 0x40, 0x92, 0x41, 0x3d, 0x0a, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12,
-	0x32, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x6e, 0x61,
-	0x6d, 0x65, 0x2e, 0x42, 0x5a, 0x5a, 0x58, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x4e, 0x61, 0x7a, 0x61, 0x72, 0x69, 0x6f, 0x75, 0x73, 0x2d, 0x75, 0x63, 0x75, 0x2f,
-	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x2e, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2f,
-	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x3b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x69, 0x74, 0x79, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x2c, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x4a, 0xb4, 0x02, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xac, 0x02, 0x0a, 0x23,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x64, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x84, 0x02, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xef, 0x01, 0x7b, 0x22, 0x63, 0x69, 0x74,
+	0x79, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x76, 0x69, 0x76, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x31, 0x2e, 0x35, 0x2c,
+	0x20, 0x22, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x53,
+	0x75, 0x6e, 0x6e, 0x79, 0x22, 0x2c, 0x20, 0x22, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x22, 0x3a, 0x20, 0x34, 0x38, 0x2c, 0x20, 0x22, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x22, 0x3a, 0x20, 0x33, 0x2e, 0x36, 0x2c, 0x20, 0x22, 0x77, 0x69, 0x6e, 0x64, 0x5f,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x32, 0x37, 0x30, 0x2c,
+	0x20, 0x22, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x31,
+	0x36, 0x2c, 0x20, 0x22, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x22, 0x3a,
+	0x20, 0x32, 0x31, 0x2e, 0x31, 0x2c, 0x20, 0x22, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x32, 0x35, 0x2d, 0x30, 0x37, 0x2d, 0x30,
+	0x31, 0x54, 0x31, 0x32, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x2c, 0x20, 0x22, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x41, 0x50, 0x49, 0x22, 0x2c, 0x20, 0x22, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x3a,
+	0x20, 0x22, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x7d, 0x4a, 0x8a, 0x01, 0x0a, 0x03, 0x34,
+	0x30, 0x30, 0x12, 0x82, 0x01, 0x0a, 0x3d, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x63,
+	0x69, 0x74, 0x79, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x20,
+	0x6c, 0x61, 0x6e, 0x67, 0x22, 0x41, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x69, 0x74, 0x79, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x20,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x48, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x41,
+	0x0a, 0x0e, 0x43, 0x69, 0x74, 0x79, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x43, 0x69, 0x74, 0x79, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22,
+	0x7d, 0x4a, 0xb5, 0x01, 0x0a, 0x03, 0x34, 0x32, 0x39, 0x12, 0xad, 0x01, 0x0a, 0x26, 0x45, 0x76,
+	0x65, 0x72, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73,
+	0x20, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x20, 0x69, 0x74, 0x73, 0x20, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x6e, 0x7b, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x6c, 0x6c, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x3a, 0x20, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x50, 0x49,
+	0x3a, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x20, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x3a, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x20, 0x34, 0x32, 0x39, 0x20, 0x54, 0x6f, 0x6f, 0x20, 0x4d, 0x61, 0x6e, 0x79, 0x20, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x7d, 0x4a, 0x51, 0x0a, 0x03, 0x35, 0x30, 0x30,
+	0x12, 0x4a, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x7b,
+	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d, 0x4a, 0xb0, 0x01, 0x0a,
+	0x03, 0x35, 0x30, 0x33, 0x12, 0xa8, 0x01, 0x0a, 0x2a, 0x4e, 0x6f, 0x20, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x22, 0x7a, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x66, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x61, 0x6c, 0x6c, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20,
+	0x41, 0x50, 0x49, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x3a, 0x20, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x50, 0x49, 0x3a, 0x20,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x3a, 0x20, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x20, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x22, 0x7d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0xab, 0x07, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x79,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd9, 0x06, 0x92, 0x41, 0xb2, 0x06, 0x0a,
+	0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x22, 0x47, 0x65, 0x74, 0x20, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x62, 0x79,
+	0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x1a, 0xbd, 0x01, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x61, 0x74, 0x20, 0x61, 0x20,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x20, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x20, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x61, 0x20, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x20, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x20, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x69,
+	0x73, 0x20, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x77, 0x69,
+	0x73, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x2e, 0x4a, 0xa1, 0x02, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x99, 0x02, 0x0a, 0x23, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x64, 0x20,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf1, 0x01, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0xdc, 0x01, 0x7b, 0x22, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x76,
+	0x69, 0x76, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x3a, 0x20, 0x32, 0x31, 0x2e, 0x35, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x75, 0x6e, 0x6e, 0x79, 0x22, 0x2c, 0x20,
+	0x22, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x34, 0x38, 0x2c, 0x20,
+	0x22, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x3a, 0x20, 0x33, 0x2e,
+	0x36, 0x2c, 0x20, 0x22, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x32, 0x37, 0x30, 0x2c, 0x20, 0x22, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x31, 0x36, 0x2c, 0x20, 0x22, 0x66, 0x65, 0x65,
+	0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x31, 0x2e, 0x31, 0x2c, 0x20,
+	0x22, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x22,
+	0x32, 0x30, 0x32, 0x35, 0x2d, 0x30, 0x37, 0x2d, 0x30, 0x31, 0x54, 0x31, 0x32, 0x3a, 0x30, 0x30,
+	0x3a, 0x30, 0x30, 0x5a, 0x22, 0x2c, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x50, 0x49, 0x22, 0x7d,
+	0x4a, 0x6c, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x65, 0x0a, 0x22, 0x4c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x20, 0x6f, 0x75, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x3f, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x2b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x6c, 0x61,
+	0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x20, 0x2d, 0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x39, 0x30, 0x22, 0x7d, 0x4a, 0xb0,
+	0x01, 0x0a, 0x03, 0x35, 0x30, 0x33, 0x12, 0xa8, 0x01, 0x0a, 0x2a, 0x4e, 0x6f, 0x20, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x69,
+	0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x20, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x7a, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x66, 0x7b, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x6c, 0x6c, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x3a, 0x20, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x50, 0x49,
+	0x3a, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x75, 0x6e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x20, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x20,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x22,
+	0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0xd2, 0x08, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x87, 0x08, 0x92, 0x41, 0xe3, 0x07, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12,
+	0x12, 0x47, 0x65, 0x74, 0x20, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x20, 0x66, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x1a, 0x59, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x20, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x20, 0x28, 0x6d,
+	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x78, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x75, 0x74, 0x6c, 0x6f, 0x6f, 0x6b, 0x29, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x63, 0x69, 0x74, 0x79, 0x4a, 0xf5,
+	0x01, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xed, 0x01, 0x0a, 0x1f, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x64, 0x20, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0xb4, 0x01, 0x7b, 0x22, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x76, 0x69, 0x76,
+	0x22, 0x2c, 0x20, 0x22, 0x64, 0x61, 0x79, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x64, 0x61,
+	0x74, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x32, 0x35, 0x2d, 0x30, 0x37, 0x2d, 0x30, 0x31,
+	0x22, 0x2c, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x34, 0x2e, 0x32, 0x2c, 0x20, 0x22, 0x6d, 0x61, 0x78,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x32,
+	0x34, 0x2e, 0x38, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3a, 0x20, 0x22, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x20, 0x72, 0x61, 0x69, 0x6e, 0x22, 0x2c, 0x20,
+	0x22, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x3a, 0x20, 0x37, 0x30, 0x2c, 0x20, 0x22, 0x70, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6d, 0x22, 0x3a, 0x20,
+	0x33, 0x2e, 0x31, 0x7d, 0x5d, 0x7d, 0x4a, 0x69, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x62, 0x0a,
+	0x21, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x72,
+	0x20, 0x64, 0x61, 0x79, 0x73, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x22, 0x3d, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x3a, 0x20, 0x22, 0x64, 0x61, 0x79, 0x73, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20,
+	0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x31, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x35, 0x22,
+	0x7d, 0x4a, 0x48, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x79,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x74, 0x79, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x7d, 0x4a, 0xb5, 0x01, 0x0a, 0x03,
+	0x34, 0x32, 0x39, 0x12, 0xad, 0x01, 0x0a, 0x26, 0x45, 0x76, 0x65, 0x72, 0x79, 0x20, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x65, 0x78, 0x68, 0x61, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x20, 0x69, 0x74, 0x73, 0x20, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x82,
+	0x01, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x6e, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
+	0x61, 0x6c, 0x6c, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x3a, 0x20,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x50, 0x49, 0x3a, 0x20, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x20, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x20, 0x65, 0x78, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x3a, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x34, 0x32, 0x39, 0x20,
+	0x54, 0x6f, 0x6f, 0x20, 0x4d, 0x61, 0x6e, 0x79, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x22, 0x7d, 0x4a, 0x51, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x4a, 0x0a, 0x15, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d, 0x4a, 0xb0, 0x01, 0x0a, 0x03, 0x35, 0x30, 0x33, 0x12, 0xa8,
+	0x01, 0x0a, 0x2a, 0x4e, 0x6f, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x6c, 0x79, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x7a, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x66, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x6c,
+	0x6c, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x3a, 0x20, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x50, 0x49, 0x3a, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x20, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x20,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x20, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x20,
+	0x69, 0x73, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12,
+	0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x2f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0xd9, 0x05, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x42, 0x79, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x92, 0x05, 0x92, 0x41, 0xf1, 0x04, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x12, 0x26, 0x47, 0x65, 0x74, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x76, 0x65, 0x72, 0x61,
+	0x6c, 0x20, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xb5, 0x01, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x75, 0x70, 0x20, 0x74, 0x6f,
+	0x20, 0x31, 0x30, 0x30, 0x20, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x61, 0x74, 0x20, 0x6f,
+	0x6e, 0x63, 0x65, 0x2c, 0x20, 0x6b, 0x65, 0x79, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x63, 0x69, 0x74, 0x79,
+	0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2e, 0x20, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x6f, 0x6e,
+	0x63, 0x65, 0x3b, 0x20, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+	0x63, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e,
+	0x4a, 0x8c, 0x02, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x84, 0x02, 0x0a, 0x2c, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x63,
+	0x69, 0x74, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x63, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62,
+	0x65, 0x20, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xbe,
+	0x01, 0x7b, 0x22, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x4c,
+	0x76, 0x69, 0x76, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x22,
+	0x4c, 0x76, 0x69, 0x76, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x31, 0x2e, 0x35, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x75, 0x6e, 0x6e, 0x79, 0x22,
+	0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x3a, 0x20, 0x7b, 0x22,
+	0x41, 0x74, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x6c, 0x6c, 0x20,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x3a, 0x20, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x41, 0x50, 0x49, 0x3a, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x3a, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x34,
+	0x30, 0x34, 0x20, 0x4e, 0x6f, 0x74, 0x20, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x7d, 0x7d, 0x4a,
+	0x77, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x70, 0x0a, 0x26, 0x4e, 0x6f, 0x20, 0x63, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x6f, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20,
+	0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x22, 0x46, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x31, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31,
+	0x30, 0x30, 0x20, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0xa0, 0x04, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd9, 0x03, 0x92, 0x41, 0xb8,
+	0x03, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x15, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x1a, 0xeb, 0x01, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x63, 0x69, 0x74, 0x79, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e,
+	0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65,
+	0x72, 0x79, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68, 0x20, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x6f, 0x76, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20,
+	0x62, 0x79, 0x20, 0x61, 0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x20, 0x74, 0x68, 0x61, 0x74,
+	0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x20, 0x6f, 0x72,
+	0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x2e, 0x4a,
+	0x5e, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x57, 0x0a, 0x22, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x20, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x31, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x1d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x69, 0x74,
+	0x79, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a,
+	0x48, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x79, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x74, 0x79, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
+	0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0xcf, 0x05, 0x0a, 0x0c, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfb, 0x04, 0x92,
+	0x41, 0xd9, 0x04, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x0d, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x20, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xfe, 0x01, 0x41, 0x75,
+	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x63, 0x69,
+	0x74, 0x79, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x2d, 0x69, 0x6e, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x63,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x20, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x20,
+	0x72, 0x65, 0x67, 0x61, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x61,
+	0x73, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x69, 0x61, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63,
+	0x73, 0x2c, 0x20, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20,
+	0x74, 0x79, 0x70, 0x6f, 0x20, 0x69, 0x6e, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x66, 0x6f, 0x75, 0x72, 0x20, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x20, 0x6f, 0x72, 0x20, 0x6d, 0x6f, 0x72, 0x65, 0x2e, 0x20, 0x45, 0x78, 0x61, 0x63, 0x74, 0x20,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x63,
+	0x6f, 0x6d, 0x65, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79,
+	0x20, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4a, 0xd9, 0x01, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0xd1, 0x01, 0x0a, 0x1b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x20, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2c, 0x20, 0x62, 0x65, 0x73, 0x74, 0x20, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x9c, 0x01, 0x7b, 0x22, 0x63, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x22,
+	0x6c, 0x76, 0x69, 0x76, 0x2d, 0x75, 0x61, 0x22, 0x2c, 0x20, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x4c, 0x76, 0x69, 0x76, 0x22, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x55, 0x41, 0x22, 0x2c, 0x20, 0x22, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x39, 0x2e, 0x38, 0x33, 0x39, 0x37, 0x2c, 0x20,
+	0x22, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x34, 0x2e,
+	0x30, 0x32, 0x39, 0x37, 0x2c, 0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x45, 0x75, 0x72, 0x6f, 0x70, 0x65, 0x2f, 0x4b, 0x79, 0x69, 0x76, 0x22, 0x2c,
+	0x20, 0x22, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x37,
+	0x31, 0x37, 0x32, 0x37, 0x33, 0x7d, 0x5d, 0x7d, 0x4a, 0x62, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12,
+	0x5b, 0x0a, 0x24, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x20, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x6f,
+	0x66, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x69,
+	0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x2f, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x9f, 0x07, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xd7, 0x06, 0x92, 0x41, 0xb4, 0x06, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x12, 0x13, 0x47, 0x65, 0x74, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x1a, 0xf5, 0x01, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x62, 0x65,
+	0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x74, 0x77, 0x6f, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x2c,
+	0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x20, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x20, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x73, 0x2e, 0x20, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x64, 0x20, 0x70, 0x65, 0x72, 0x20, 0x68, 0x6f, 0x75, 0x72, 0x20, 0x6f, 0x72, 0x20,
+	0x70, 0x65, 0x72, 0x20, 0x64, 0x61, 0x79, 0x20, 0x28, 0x55, 0x54, 0x43, 0x29, 0x20, 0x75, 0x6e,
+	0x6c, 0x65, 0x73, 0x73, 0x20, 0x72, 0x61, 0x77, 0x20, 0x6f, 0x6e, 0x65, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x20, 0x4f, 0x6e, 0x6c,
+	0x79, 0x20, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x68, 0x61,
+	0x76, 0x65, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x6c, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x20, 0x75,
+	0x70, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x2e, 0x4a,
+	0xcc, 0x02, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xc4, 0x02, 0x0a, 0x39, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74,
+	0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x3b, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x6e, 0x65, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x65, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xf1, 0x01, 0x7b, 0x22, 0x63,
+	0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x4b, 0x79, 0x69, 0x76, 0x22, 0x2c, 0x20, 0x22, 0x67,
+	0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x6f,
+	0x75, 0x72, 0x22, 0x2c, 0x20, 0x22, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x7b, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x32, 0x35, 0x2d, 0x30,
+	0x37, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x39, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x2c,
+	0x20, 0x22, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x20,
+	0x32, 0x31, 0x2e, 0x34, 0x2c, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x30, 0x2e, 0x39, 0x2c, 0x20, 0x22,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x3a, 0x20, 0x32, 0x32, 0x2c, 0x20, 0x22, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6b,
+	0x65, 0x22, 0x3a, 0x20, 0x32, 0x31, 0x2e, 0x31, 0x2c, 0x20, 0x22, 0x68, 0x75, 0x6d, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x35, 0x38, 0x2c, 0x20, 0x22, 0x77, 0x69, 0x6e, 0x64, 0x5f,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x3a, 0x20, 0x33, 0x2e, 0x32, 0x2c, 0x20, 0x22, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x31, 0x34, 0x2c, 0x20, 0x22,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x34, 0x7d, 0x5d, 0x7d, 0x4a, 0x7a,
+	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x73, 0x0a, 0x38, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x20, 0x63, 0x69, 0x74, 0x79, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x67,
+	0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x22, 0x37, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x6f, 0x22, 0x7d, 0x4a, 0x51, 0x0a, 0x03, 0x35, 0x30,
+	0x30, 0x12, 0x4a, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1d,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x1a, 0x5b, 0x92, 0x41,
+	0x58, 0x0a, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x4d, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x64,
+	0x61, 0x79, 0x20, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x20, 0x62, 0x79, 0x20,
+	0x63, 0x69, 0x74, 0x79, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x42, 0x5a, 0x5a, 0x58, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x61, 0x7a, 0x61, 0x72, 0x69, 0x6f, 0x75,
+	0x73, 0x2d, 0x75, 0x63, 0x75, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x2e,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x3b, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -213,19 +1823,61 @@ func file_v1_alpha_weather_weather_proto_rawDescGZIP() []byte {
 	return file_v1_alpha_weather_weather_proto_rawDescData
 }
 
-var file_v1_alpha_weather_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_alpha_weather_weather_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_alpha_weather_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_v1_alpha_weather_weather_proto_goTypes = []any{
-	(*WeatherRequest)(nil),  // 0: weather.v1.WeatherRequest
-	(*WeatherResponse)(nil), // 1: weather.v1.WeatherResponse
+	(Condition)(0),                // 0: weather.v1.Condition
+	(*WeatherRequest)(nil),        // 1: weather.v1.WeatherRequest
+	(*WeatherResponse)(nil),       // 2: weather.v1.WeatherResponse
+	(*CoordinatesRequest)(nil),    // 3: weather.v1.CoordinatesRequest
+	(*ForecastRequest)(nil),       // 4: weather.v1.ForecastRequest
+	(*DailyForecast)(nil),         // 5: weather.v1.DailyForecast
+	(*ForecastResponse)(nil),      // 6: weather.v1.ForecastResponse
+	(*WatchRequest)(nil),          // 7: weather.v1.WatchRequest
+	(*CitiesRequest)(nil),         // 8: weather.v1.CitiesRequest
+	(*CitiesResponse)(nil),        // 9: weather.v1.CitiesResponse
+	(*SearchCitiesRequest)(nil),   // 10: weather.v1.SearchCitiesRequest
+	(*City)(nil),                  // 11: weather.v1.City
+	(*SearchCitiesResponse)(nil),  // 12: weather.v1.SearchCitiesResponse
+	(*HistoryRequest)(nil),        // 13: weather.v1.HistoryRequest
+	(*HistoryPoint)(nil),          // 14: weather.v1.HistoryPoint
+	(*HistoryResponse)(nil),       // 15: weather.v1.HistoryResponse
+	nil,                           // 16: weather.v1.CitiesResponse.WeatherEntry
+	nil,                           // 17: weather.v1.CitiesResponse.ErrorsEntry
+	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
 }
 var file_v1_alpha_weather_weather_proto_depIdxs = []int32{
-	0, // 0: weather.v1.WeatherService.GetByCity:input_type -> weather.v1.WeatherRequest
-	1, // 1: weather.v1.WeatherService.GetByCity:output_type -> weather.v1.WeatherResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	18, // 0: weather.v1.WeatherResponse.observed_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: weather.v1.WeatherResponse.condition_code:type_name -> weather.v1.Condition
+	0,  // 2: weather.v1.DailyForecast.condition_code:type_name -> weather.v1.Condition
+	5,  // 3: weather.v1.ForecastResponse.days:type_name -> weather.v1.DailyForecast
+	16, // 4: weather.v1.CitiesResponse.weather:type_name -> weather.v1.CitiesResponse.WeatherEntry
+	17, // 5: weather.v1.CitiesResponse.errors:type_name -> weather.v1.CitiesResponse.ErrorsEntry
+	11, // 6: weather.v1.SearchCitiesResponse.cities:type_name -> weather.v1.City
+	18, // 7: weather.v1.HistoryRequest.from:type_name -> google.protobuf.Timestamp
+	18, // 8: weather.v1.HistoryRequest.to:type_name -> google.protobuf.Timestamp
+	18, // 9: weather.v1.HistoryPoint.time:type_name -> google.protobuf.Timestamp
+	14, // 10: weather.v1.HistoryResponse.points:type_name -> weather.v1.HistoryPoint
+	2,  // 11: weather.v1.CitiesResponse.WeatherEntry.value:type_name -> weather.v1.WeatherResponse
+	1,  // 12: weather.v1.WeatherService.GetByCity:input_type -> weather.v1.WeatherRequest
+	3,  // 13: weather.v1.WeatherService.GetByCoordinates:input_type -> weather.v1.CoordinatesRequest
+	4,  // 14: weather.v1.WeatherService.GetForecast:input_type -> weather.v1.ForecastRequest
+	8,  // 15: weather.v1.WeatherService.GetByCities:input_type -> weather.v1.CitiesRequest
+	7,  // 16: weather.v1.WeatherService.WatchCity:input_type -> weather.v1.WatchRequest
+	10, // 17: weather.v1.WeatherService.SearchCities:input_type -> weather.v1.SearchCitiesRequest
+	13, // 18: weather.v1.WeatherService.GetHistory:input_type -> weather.v1.HistoryRequest
+	2,  // 19: weather.v1.WeatherService.GetByCity:output_type -> weather.v1.WeatherResponse
+	2,  // 20: weather.v1.WeatherService.GetByCoordinates:output_type -> weather.v1.WeatherResponse
+	6,  // 21: weather.v1.WeatherService.GetForecast:output_type -> weather.v1.ForecastResponse
+	9,  // 22: weather.v1.WeatherService.GetByCities:output_type -> weather.v1.CitiesResponse
+	2,  // 23: weather.v1.WeatherService.WatchCity:output_type -> weather.v1.WeatherResponse
+	12, // 24: weather.v1.WeatherService.SearchCities:output_type -> weather.v1.SearchCitiesResponse
+	15, // 25: weather.v1.WeatherService.GetHistory:output_type -> weather.v1.HistoryResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_v1_alpha_weather_weather_proto_init() }
@@ -258,19 +1910,176 @@ func file_v1_alpha_weather_weather_proto_init() {
 				return nil
 			}
 		}
+		file_v1_alpha_weather_weather_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CoordinatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ForecastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DailyForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ForecastResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchCitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*City); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchCitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoryPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_alpha_weather_weather_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_alpha_weather_weather_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1_alpha_weather_weather_proto_goTypes,
 		DependencyIndexes: file_v1_alpha_weather_weather_proto_depIdxs,
+		EnumInfos:         file_v1_alpha_weather_weather_proto_enumTypes,
 		MessageInfos:      file_v1_alpha_weather_weather_proto_msgTypes,
 	}.Build()
 	File_v1_alpha_weather_weather_proto = out.File
@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	WeatherService_GetByCity_FullMethodName = "/weather.v1.WeatherService/GetByCity"
+	WeatherService_GetByCity_FullMethodName        = "/weather.v1.WeatherService/GetByCity"
+	WeatherService_GetByCoordinates_FullMethodName = "/weather.v1.WeatherService/GetByCoordinates"
+	WeatherService_GetForecast_FullMethodName      = "/weather.v1.WeatherService/GetForecast"
+	WeatherService_GetByCities_FullMethodName      = "/weather.v1.WeatherService/GetByCities"
+	WeatherService_WatchCity_FullMethodName        = "/weather.v1.WeatherService/WatchCity"
+	WeatherService_SearchCities_FullMethodName     = "/weather.v1.WeatherService/SearchCities"
+	WeatherService_GetHistory_FullMethodName       = "/weather.v1.WeatherService/GetHistory"
 )
 
 // WeatherServiceClient is the client API for WeatherService service.
@@ -27,6 +33,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeatherServiceClient interface {
 	GetByCity(ctx context.Context, in *WeatherRequest, opts ...grpc.CallOption) (*WeatherResponse, error)
+	GetByCoordinates(ctx context.Context, in *CoordinatesRequest, opts ...grpc.CallOption) (*WeatherResponse, error)
+	GetForecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*ForecastResponse, error)
+	GetByCities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error)
+	WatchCity(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (WeatherService_WatchCityClient, error)
+	SearchCities(ctx context.Context, in *SearchCitiesRequest, opts ...grpc.CallOption) (*SearchCitiesResponse, error)
+	GetHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
 }
 
 type weatherServiceClient struct {
@@ -47,11 +59,100 @@ func (c *weatherServiceClient) GetByCity(ctx context.Context, in *WeatherRequest
 	return out, nil
 }
 
+func (c *weatherServiceClient) GetByCoordinates(ctx context.Context, in *CoordinatesRequest, opts ...grpc.CallOption) (*WeatherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WeatherResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetByCoordinates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetForecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*ForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForecastResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetByCities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CitiesResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetByCities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) WatchCity(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (WeatherService_WatchCityClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WeatherService_ServiceDesc.Streams[0], WeatherService_WatchCity_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weatherServiceWatchCityClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WeatherService_WatchCityClient interface {
+	Recv() (*WeatherResponse, error)
+	grpc.ClientStream
+}
+
+type weatherServiceWatchCityClient struct {
+	grpc.ClientStream
+}
+
+func (x *weatherServiceWatchCityClient) Recv() (*WeatherResponse, error) {
+	m := new(WeatherResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weatherServiceClient) SearchCities(ctx context.Context, in *SearchCitiesRequest, opts ...grpc.CallOption) (*SearchCitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCitiesResponse)
+	err := c.cc.Invoke(ctx, WeatherService_SearchCities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeatherServiceServer is the server API for WeatherService service.
 // All implementations must embed UnimplementedWeatherServiceServer
 // for forward compatibility
 type WeatherServiceServer interface {
 	GetByCity(context.Context, *WeatherRequest) (*WeatherResponse, error)
+	GetByCoordinates(context.Context, *CoordinatesRequest) (*WeatherResponse, error)
+	GetForecast(context.Context, *ForecastRequest) (*ForecastResponse, error)
+	GetByCities(context.Context, *CitiesRequest) (*CitiesResponse, error)
+	WatchCity(*WatchRequest, WeatherService_WatchCityServer) error
+	SearchCities(context.Context, *SearchCitiesRequest) (*SearchCitiesResponse, error)
+	GetHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
 	mustEmbedUnimplementedWeatherServiceServer()
 }
 
@@ -62,6 +163,24 @@ type UnimplementedWeatherServiceServer struct {
 func (UnimplementedWeatherServiceServer) GetByCity(context.Context, *WeatherRequest) (*WeatherResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByCity not implemented")
 }
+func (UnimplementedWeatherServiceServer) GetByCoordinates(context.Context, *CoordinatesRequest) (*WeatherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByCoordinates not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetForecast(context.Context, *ForecastRequest) (*ForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForecast not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetByCities(context.Context, *CitiesRequest) (*CitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByCities not implemented")
+}
+func (UnimplementedWeatherServiceServer) WatchCity(*WatchRequest, WeatherService_WatchCityServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCity not implemented")
+}
+func (UnimplementedWeatherServiceServer) SearchCities(context.Context, *SearchCitiesRequest) (*SearchCitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCities not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
 func (UnimplementedWeatherServiceServer) mustEmbedUnimplementedWeatherServiceServer() {}
 
 // UnsafeWeatherServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +212,117 @@ func _WeatherService_GetByCity_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WeatherService_GetByCoordinates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoordinatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetByCoordinates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetByCoordinates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetByCoordinates(ctx, req.(*CoordinatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetForecast(ctx, req.(*ForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetByCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetByCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetByCities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetByCities(ctx, req.(*CitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_WatchCity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeatherServiceServer).WatchCity(m, &weatherServiceWatchCityServer{ServerStream: stream})
+}
+
+type WeatherService_WatchCityServer interface {
+	Send(*WeatherResponse) error
+	grpc.ServerStream
+}
+
+type weatherServiceWatchCityServer struct {
+	grpc.ServerStream
+}
+
+func (x *weatherServiceWatchCityServer) Send(m *WeatherResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WeatherService_SearchCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).SearchCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_SearchCities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).SearchCities(ctx, req.(*SearchCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WeatherService_ServiceDesc is the grpc.ServiceDesc for WeatherService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,7 +334,33 @@ var WeatherService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByCity",
 			Handler:    _WeatherService_GetByCity_Handler,
 		},
+		{
+			MethodName: "GetByCoordinates",
+			Handler:    _WeatherService_GetByCoordinates_Handler,
+		},
+		{
+			MethodName: "GetForecast",
+			Handler:    _WeatherService_GetForecast_Handler,
+		},
+		{
+			MethodName: "GetByCities",
+			Handler:    _WeatherService_GetByCities_Handler,
+		},
+		{
+			MethodName: "SearchCities",
+			Handler:    _WeatherService_SearchCities_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _WeatherService_GetHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCity",
+			Handler:       _WeatherService_WatchCity_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1.alpha/weather/weather.proto",
 }
@@ -42,7 +42,7 @@ const (
 )
 
 type weatherGetterService interface {
-	GetByCity(ctx context.Context, city string) (models.WeatherData, error)
+	GetByCity(ctx context.Context, city, units string) (models.WeatherData, error)
 }
 
 type ServiceContainer struct {
@@ -215,7 +215,7 @@ func (a *App) init() ServiceContainer {
 		grpc.UnaryInterceptor(m.UnaryServerInterceptor()),
 		grpc.StreamInterceptor(m.StreamServerInterceptor()),
 	)
-	subs.RegisterSubscriptionServiceServer(grpcServer, grpc2.NewSubscriptionGRPCServer(subSvc))
+	subs.RegisterSubscriptionServiceServer(grpcServer, grpc2.NewSubscriptionGRPCServer(subSvc, n))
 
 	// Start gRPC
 	go func() {
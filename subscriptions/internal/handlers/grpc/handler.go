@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/subscriptions/internal/models"
 
@@ -9,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type subscriber interface {
@@ -17,23 +19,35 @@ type subscriber interface {
 	Unsubscribe(ctx context.Context, token string) (bool, error)
 }
 
+type scheduler interface {
+	Schedule(ctx context.Context, now time.Time) ([]models.ScheduledRun, error)
+}
+
 type SubscriptionGRPCServer struct {
 	subs.UnimplementedSubscriptionServiceServer
-	service subscriber
+	service  subscriber
+	schedule scheduler
 }
 
-func NewSubscriptionGRPCServer(service subscriber) *SubscriptionGRPCServer {
-	return &SubscriptionGRPCServer{service: service}
+func NewSubscriptionGRPCServer(service subscriber, schedule scheduler) *SubscriptionGRPCServer {
+	return &SubscriptionGRPCServer{service: service, schedule: schedule}
 }
 
 func (s *SubscriptionGRPCServer) Subscribe(
 	ctx context.Context,
 	req *subs.SubscribeRequest,
 ) (*subs.MessageResponse, error) {
+	switch req.GetUnits() {
+	case "", models.UnitsMetric, models.UnitsImperial, models.UnitsStandard:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "units must be one of metric, imperial and standard")
+	}
+
 	data := models.UserSubData{
 		Email:     req.GetEmail(),
 		City:      req.GetCity(),
 		Frequency: req.GetFrequency(),
+		Units:     req.GetUnits(),
 	}
 
 	err := s.service.Subscribe(ctx, data)
@@ -71,3 +85,23 @@ func (s *SubscriptionGRPCServer) Unsubscribe(
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *SubscriptionGRPCServer) GetNotificationSchedule(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*subs.NotificationSchedule, error) {
+	runs, err := s.schedule.Schedule(ctx, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "schedule error: %v", err)
+	}
+
+	resp := &subs.NotificationSchedule{}
+	for _, run := range runs {
+		resp.Runs = append(resp.Runs, &subs.ScheduledRun{
+			Frequency: run.Frequency,
+			NextRun:   timestamppb.New(run.Next),
+			Cities:    run.Cities,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// ScheduledRun is the next notifier run for a frequency and the cities it will
+// fetch weather for.
+type ScheduledRun struct {
+	Frequency string
+	Next      time.Time
+	Cities    []string
+}
@@ -2,11 +2,20 @@ package models
 
 import "time"
 
+// Unit systems a subscriber can receive weather in; the weather service
+// reports in metric unless asked otherwise.
+const (
+	UnitsMetric   = "metric"
+	UnitsImperial = "imperial"
+	UnitsStandard = "standard"
+)
+
 type Subscription struct {
 	ID         int
 	Email      string
 	City       string
 	Frequency  string
+	Units      string
 	LastSentAt *time.Time
 }
 
@@ -14,4 +23,5 @@ type UserSubData struct {
 	Email     string `json:"email" binding:"required,email"`
 	City      string `json:"city" binding:"required"`
 	Frequency string `json:"frequency" binding:"required,oneof=hourly daily"`
+	Units     string `json:"units,omitempty" binding:"omitempty,oneof=metric imperial standard"`
 }
@@ -1,7 +1,18 @@
 package models
 
+import "time"
+
 type WeatherData struct {
-	City        string  `json:"city"`
-	Temperature float64 `json:"temperature"`
-	Condition   string  `json:"condition"`
+	City          string    `json:"city"`
+	Temperature   float64   `json:"temperature"`
+	Condition     string    `json:"condition"`
+	ConditionCode string    `json:"condition_code,omitempty"`
+	Humidity      int       `json:"humidity"`
+	WindSpeed     float64   `json:"wind_speed"`
+	WindDirection int       `json:"wind_direction"`
+	Pressure      float64   `json:"pressure"`
+	FeelsLike     float64   `json:"feels_like"`
+	ObservedAt    time.Time `json:"observed_at"`
+	Provider      string    `json:"provider"`
+	Units         string    `json:"units,omitempty"`
 }
@@ -2,6 +2,7 @@ package notifier
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -16,10 +17,14 @@ const (
 
 	freqHourly = "hourly"
 	freqDaily  = "daily"
+
+	// maxCitiesPerCall matches the weather service's GetByCities limit.
+	maxCitiesPerCall = 100
 )
 
 type subscriptionRepository interface {
 	GetConfirmedByFrequency(ctx context.Context, frequency string) ([]models.Subscription, error)
+	GetCitiesByFrequency(ctx context.Context, frequency string) ([]string, error)
 	UpdateLastSent(ctx context.Context, subscriptionID int) error
 }
 
@@ -28,9 +33,23 @@ type emailSender interface {
 }
 
 type weatherGetter interface {
-	GetByCity(ctx context.Context, city string) (models.WeatherData, error)
+	GetByCity(ctx context.Context, city, units string) (models.WeatherData, error)
+	GetByCities(ctx context.Context, cities []string, units string) (map[string]models.WeatherData, error)
+}
+
+// delivery identifies the weather a group of subscribers receives: a city in
+// the units they asked for.
+type delivery struct {
+	city  string
+	units string
 }
 
+// specParser parses schedules the way cron.WithSeconds does, so Schedule reports
+// the same run times the jobs fire at.
+var specParser = cron.NewParser(
+	cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
 // Notifier schedules and sends weather updates to subscribers.
 type Notifier struct {
 	repo           subscriptionRepository
@@ -99,6 +118,34 @@ func (n *Notifier) Stop() {
 	n.logger.Info().Msg("All cron jobs finished, notifier stopped")
 }
 
+// Schedule reports the first hourly and daily runs after now, each with the
+// distinct cities whose weather it will fetch.
+func (n *Notifier) Schedule(ctx context.Context, now time.Time) ([]models.ScheduledRun, error) {
+	specs := []struct{ frequency, spec string }{
+		{freqHourly, n.hourlySpec},
+		{freqDaily, n.dailySpec},
+	}
+
+	runs := make([]models.ScheduledRun, 0, len(specs))
+	for _, s := range specs {
+		schedule, err := specParser.Parse(s.spec)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s schedule %q: %w", s.frequency, s.spec, err)
+		}
+		cities, err := n.repo.GetCitiesByFrequency(ctx, s.frequency)
+		if err != nil {
+			n.m.TechnicalErrors.WithLabelValues("fetch_cities", "critical").Inc()
+			return nil, fmt.Errorf("list %s cities: %w", s.frequency, err)
+		}
+		runs = append(runs, models.ScheduledRun{
+			Frequency: s.frequency,
+			Next:      schedule.Next(now),
+			Cities:    cities,
+		})
+	}
+	return runs, nil
+}
+
 // RunDue fetches due subscriptions and sends updates.
 func (n *Notifier) RunDue(ctx context.Context, frequency string) {
 	start := time.Now()
@@ -121,21 +168,42 @@ func (n *Notifier) RunDue(ctx context.Context, frequency string) {
 	}
 	n.logger.Info().Str("frequency", frequency).Int("count", len(subs)).Msg("fetched due subscriptions")
 
+	// Group by city and units so every distinct pair is fetched once per run
+	byDelivery := make(map[delivery][]models.Subscription)
+	for _, sub := range subs {
+		d := delivery{city: sub.City, units: sub.Units}
+		byDelivery[d] = append(byDelivery[d], sub)
+	}
+	weather := n.fetchWeather(ctx, byDelivery)
+
 	var wg sync.WaitGroup
-	wg.Add(len(subs))
 
 	// Send updates concurrently
-	for _, sub := range subs {
-		s := sub
-		go func() {
-			defer wg.Done()
-			if err := n.SendOne(ctx, s); err != nil {
-				n.logger.Error().Err(err).
-					Int("subscription_id", s.ID).
-					Msg("error sending update")
-				n.m.TechnicalErrors.WithLabelValues("send_one", "critical").Inc()
-			}
-		}()
+	for d, citySubs := range byDelivery {
+		data, ok := weather[d]
+		if !ok {
+			n.logger.Error().
+				Str("city", d.city).
+				Str("units", d.units).
+				Int("subscriptions", len(citySubs)).
+				Msg("no weather for city, skipping its subscribers")
+			n.m.TechnicalErrors.WithLabelValues("weather_fetch_error", "critical").Inc()
+			continue
+		}
+
+		wg.Add(len(citySubs))
+		for _, sub := range citySubs {
+			s := sub
+			go func() {
+				defer wg.Done()
+				if err := n.deliver(ctx, s, data); err != nil {
+					n.logger.Error().Err(err).
+						Int("subscription_id", s.ID).
+						Msg("error sending update")
+					n.m.TechnicalErrors.WithLabelValues("send_one", "critical").Inc()
+				}
+			}()
+		}
 	}
 
 	wg.Wait()
@@ -148,11 +216,10 @@ func (n *Notifier) RunDue(ctx context.Context, frequency string) {
 
 // SendOne obtains forecast and emails a single subscriber, then updates last_sent.
 func (n *Notifier) SendOne(ctx context.Context, sub models.Subscription) error {
-	start := time.Now()
 	n.logger.Debug().Int("subscription_id", sub.ID).Str("city", sub.City).Msg("SendOne start")
 
 	// Fetch weather
-	forecast, err := n.weatherService.GetByCity(ctx, sub.City)
+	forecast, err := n.weatherService.GetByCity(ctx, sub.City, sub.Units)
 	if err != nil {
 		n.logger.Error().Err(err).
 			Int("subscription_id", sub.ID).
@@ -161,6 +228,45 @@ func (n *Notifier) SendOne(ctx context.Context, sub models.Subscription) error {
 		return err
 	}
 
+	return n.deliver(ctx, sub, forecast)
+}
+
+// fetchWeather fetches weather for every distinct city in batches, one series of
+// batches per unit system. Deliveries that could not be fetched are absent from
+// the result.
+func (n *Notifier) fetchWeather(
+	ctx context.Context,
+	byDelivery map[delivery][]models.Subscription,
+) map[delivery]models.WeatherData {
+	citiesByUnits := make(map[string][]string)
+	for d := range byDelivery {
+		citiesByUnits[d.units] = append(citiesByUnits[d.units], d.city)
+	}
+
+	weather := make(map[delivery]models.WeatherData, len(byDelivery))
+	for units, cities := range citiesByUnits {
+		for start := 0; start < len(cities); start += maxCitiesPerCall {
+			batch := cities[start:min(start+maxCitiesPerCall, len(cities))]
+			data, err := n.weatherService.GetByCities(ctx, batch, units)
+			if err != nil {
+				n.logger.Error().Err(err).
+					Int("cities", len(batch)).
+					Str("units", units).
+					Msg("weather batch fetch error")
+				continue
+			}
+			for city, w := range data {
+				weather[delivery{city: city, units: units}] = w
+			}
+		}
+	}
+	return weather
+}
+
+// deliver emails already fetched weather to a single subscriber, then updates last_sent.
+func (n *Notifier) deliver(ctx context.Context, sub models.Subscription, forecast models.WeatherData) error {
+	start := time.Now()
+
 	// Send email
 	if err := n.emailService.SendWeather(ctx, sub.Email, forecast); err != nil {
 		n.logger.Error().Err(err).
@@ -182,6 +288,6 @@ func (n *Notifier) SendOne(ctx context.Context, sub models.Subscription) error {
 	dur := time.Since(start)
 	n.logger.Info().Int("subscription_id", sub.ID).
 		Dur("duration", dur).
-		Msg("SendOne completed successfully")
+		Msg("update delivered successfully")
 	return nil
 }
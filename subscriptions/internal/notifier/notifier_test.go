@@ -38,6 +38,12 @@ func (m *mockRepo) GetConfirmedByFrequency(
 	return data, args.Error(1)
 }
 
+func (m *mockRepo) GetCitiesByFrequency(ctx context.Context, frequency string) ([]string, error) {
+	args := m.Called(ctx, frequency)
+	data, _ := args.Get(0).([]string)
+	return data, args.Error(1)
+}
+
 func (m *mockRepo) UpdateLastSent(ctx context.Context, subscriptionID int) error {
 	args := m.Called(ctx, subscriptionID)
 	return args.Error(0)
@@ -47,8 +53,8 @@ type mockWeather struct {
 	mock.Mock
 }
 
-func (m *mockWeather) GetByCity(ctx context.Context, city string) (models.WeatherData, error) {
-	args := m.Called(ctx, city)
+func (m *mockWeather) GetByCity(ctx context.Context, city, units string) (models.WeatherData, error) {
+	args := m.Called(ctx, city, units)
 	data, ok := args.Get(0).(models.WeatherData)
 	if !ok {
 		return models.WeatherData{}, args.Error(1)
@@ -57,6 +63,20 @@ func (m *mockWeather) GetByCity(ctx context.Context, city string) (models.Weathe
 	return data, args.Error(1)
 }
 
+func (m *mockWeather) GetByCities(
+	ctx context.Context,
+	cities []string,
+	units string,
+) (map[string]models.WeatherData, error) {
+	args := m.Called(ctx, cities, units)
+	data, ok := args.Get(0).(map[string]models.WeatherData)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return data, args.Error(1)
+}
+
 type mockEmail struct {
 	mock.Mock
 }
@@ -80,7 +100,7 @@ func Test_sendOne_Success(t *testing.T) {
 	mockR.On("UpdateLastSent", mock.Anything, sub.ID).Return(nil)
 
 	forecast := models.WeatherData{City: city, Temperature: 5.0, Condition: "Sunny"}
-	mockW.On("GetByCity", mock.Anything, city).Return(forecast, nil)
+	mockW.On("GetByCity", mock.Anything, city, "").Return(forecast, nil)
 	mockE.On("SendWeather", mock.Anything, email, forecast).Return(nil)
 
 	t.Cleanup(func() {
@@ -109,7 +129,7 @@ func Test_sendOne_Error_APIError(t *testing.T) {
 	wm := &mockWeather{}
 	em := &mockEmail{}
 
-	wm.On("GetByCity", mock.Anything, city).Return(models.WeatherData{}, errors.New("api down"))
+	wm.On("GetByCity", mock.Anything, city, "").Return(models.WeatherData{}, errors.New("api down"))
 
 	t.Cleanup(func() {
 		rm.AssertExpectations(t)
@@ -136,7 +156,7 @@ func Test_sendOne_Error_EmailError(t *testing.T) {
 
 	em := &mockEmail{}
 
-	wm.On("GetByCity", mock.Anything, city).Return(models.WeatherData{City: city}, nil)
+	wm.On("GetByCity", mock.Anything, city, "").Return(models.WeatherData{City: city}, nil)
 	em.On("SendWeather", mock.Anything, sub.Email, mock.Anything).Return(errors.New("smtp fail"))
 
 	t.Cleanup(func() {
@@ -168,9 +188,13 @@ func Test_runDue_Success(t *testing.T) {
 	rm.On("UpdateLastSent", mock.Anything, 10).Return(nil)
 	rm.On("UpdateLastSent", mock.Anything, 20).Return(nil)
 
-	// weather calls
-	wm.On("GetByCity", mock.Anything, city1).Return(models.WeatherData{City: city1}, nil)
-	wm.On("GetByCity", mock.Anything, city2).Return(models.WeatherData{City: city2}, nil)
+	// one batch weather call for both cities
+	wm.On("GetByCities", mock.Anything, mock.MatchedBy(func(cities []string) bool {
+		return assert.ElementsMatch(t, []string{city1, city2}, cities)
+	}), "").Return(map[string]models.WeatherData{
+		city1: {City: city1},
+		city2: {City: city2},
+	}, nil).Once()
 
 	// email sends
 	em.On("SendWeather", mock.Anything, "a", mock.Anything).Return(nil)
@@ -211,7 +235,7 @@ func Test_runDue_FetchError(t *testing.T) {
 		Return([]models.Subscription{}, errors.New("db down"))
 
 	mockR.AssertNumberOfCalls(t, "UpdateLastSent", 0)
-	mockW.AssertNumberOfCalls(t, "GetByCity", 0)
+	mockW.AssertNumberOfCalls(t, "GetByCities", 0)
 	mockE.AssertNumberOfCalls(t, "SendWeather", 0)
 
 	n.RunDue(context.Background(), freqTest)
@@ -220,3 +244,159 @@ func Test_runDue_FetchError(t *testing.T) {
 		mockR.AssertExpectations(t)
 	})
 }
+
+func Test_runDue_GroupsByCity(t *testing.T) {
+	const city, missing = "Kyiv", "Atlantis"
+	subs := []models.Subscription{
+		{ID: 1, City: city, Email: "a"},
+		{ID: 2, City: city, Email: "b"},
+		{ID: 3, City: city, Email: "c"},
+		{ID: 4, City: missing, Email: "d"},
+	}
+	kyiv := models.WeatherData{City: city, Temperature: 12}
+
+	rm := &mockRepo{}
+	wm := &mockWeather{}
+	em := &mockEmail{}
+
+	rm.On("GetConfirmedByFrequency", freqTest, mock.Anything).Return(subs, nil)
+	wm.On("GetByCities", mock.Anything, mock.MatchedBy(func(cities []string) bool {
+		return assert.ElementsMatch(t, []string{city, missing}, cities)
+	}), "").Return(map[string]models.WeatherData{city: kyiv}, nil).Once()
+
+	for _, sub := range subs[:3] {
+		em.On("SendWeather", mock.Anything, sub.Email, kyiv).Return(nil).Once()
+		rm.On("UpdateLastSent", mock.Anything, sub.ID).Return(nil).Once()
+	}
+
+	t.Cleanup(func() {
+		rm.AssertExpectations(t)
+		wm.AssertExpectations(t)
+		em.AssertExpectations(t)
+		wm.AssertNotCalled(t, "GetByCity", mock.Anything, mock.Anything, mock.Anything)
+		em.AssertNotCalled(t, "SendWeather", mock.Anything, "d", mock.Anything)
+	})
+
+	l, err := logger.NewLogger("logs/subscriptions_test.log", "notifier_test")
+	require.NoError(t, err)
+
+	m := metrics.NewMetrics("notifier_test", &sql.DB{}, "test")
+
+	n := notifier.New(rm, wm, em, l, "@every 1h", "0 0 9 * * *", m)
+	n.RunDue(context.Background(), freqTest)
+}
+
+// Cities are stored as entered, so the batch is asked for them unnormalized and
+// answers keyed by the same strings.
+func Test_runDue_PaddedCity(t *testing.T) {
+	const padded, plain = " Kyiv ", "Kyiv"
+	subs := []models.Subscription{
+		{ID: 1, City: padded, Email: "a"},
+		{ID: 2, City: plain, Email: "b"},
+	}
+	kyiv := models.WeatherData{City: plain, Temperature: 12}
+
+	rm := &mockRepo{}
+	wm := &mockWeather{}
+	em := &mockEmail{}
+
+	rm.On("GetConfirmedByFrequency", freqTest, mock.Anything).Return(subs, nil)
+	wm.On("GetByCities", mock.Anything, mock.MatchedBy(func(cities []string) bool {
+		return assert.ElementsMatch(t, []string{padded, plain}, cities)
+	}), "").Return(map[string]models.WeatherData{padded: kyiv, plain: kyiv}, nil).Once()
+
+	for _, sub := range subs {
+		em.On("SendWeather", mock.Anything, sub.Email, kyiv).Return(nil).Once()
+		rm.On("UpdateLastSent", mock.Anything, sub.ID).Return(nil).Once()
+	}
+
+	t.Cleanup(func() {
+		rm.AssertExpectations(t)
+		wm.AssertExpectations(t)
+		em.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("logs/subscriptions_test.log", "notifier_test")
+	require.NoError(t, err)
+
+	m := metrics.NewMetrics("notifier_test", &sql.DB{}, "test")
+
+	n := notifier.New(rm, wm, em, l, "@every 1h", "0 0 9 * * *", m)
+	n.RunDue(context.Background(), freqTest)
+}
+
+func Test_runDue_GroupsByUnits(t *testing.T) {
+	const city = "Kyiv"
+	subs := []models.Subscription{
+		{ID: 1, City: city, Email: "a", Units: models.UnitsMetric},
+		{ID: 2, City: city, Email: "b", Units: models.UnitsImperial},
+		{ID: 3, City: city, Email: "c", Units: models.UnitsImperial},
+	}
+	metric := models.WeatherData{City: city, Temperature: 20, Units: models.UnitsMetric}
+	imperial := models.WeatherData{City: city, Temperature: 68, Units: models.UnitsImperial}
+
+	rm := &mockRepo{}
+	wm := &mockWeather{}
+	em := &mockEmail{}
+
+	rm.On("GetConfirmedByFrequency", freqTest, mock.Anything).Return(subs, nil)
+	wm.On("GetByCities", mock.Anything, []string{city}, models.UnitsMetric).
+		Return(map[string]models.WeatherData{city: metric}, nil).Once()
+	wm.On("GetByCities", mock.Anything, []string{city}, models.UnitsImperial).
+		Return(map[string]models.WeatherData{city: imperial}, nil).Once()
+
+	em.On("SendWeather", mock.Anything, "a", metric).Return(nil).Once()
+	em.On("SendWeather", mock.Anything, "b", imperial).Return(nil).Once()
+	em.On("SendWeather", mock.Anything, "c", imperial).Return(nil).Once()
+	for _, sub := range subs {
+		rm.On("UpdateLastSent", mock.Anything, sub.ID).Return(nil).Once()
+	}
+
+	t.Cleanup(func() {
+		rm.AssertExpectations(t)
+		wm.AssertExpectations(t)
+		em.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("logs/subscriptions_test.log", "notifier_test")
+	require.NoError(t, err)
+
+	m := metrics.NewMetrics("notifier_test", &sql.DB{}, "test")
+
+	n := notifier.New(rm, wm, em, l, "@every 1h", "0 0 9 * * *", m)
+	n.RunDue(context.Background(), freqTest)
+}
+
+func Test_schedule(t *testing.T) {
+	rm := &mockRepo{}
+	rm.On("GetCitiesByFrequency", mock.Anything, "hourly").Return([]string{"Kyiv", "Lviv"}, nil).Once()
+	rm.On("GetCitiesByFrequency", mock.Anything, "daily").Return([]string{"Odesa"}, nil).Once()
+	t.Cleanup(func() { rm.AssertExpectations(t) })
+
+	l, err := logger.NewLogger("logs/subscriptions_test.log", "notifier_test")
+	require.NoError(t, err)
+
+	m := metrics.NewMetrics("notifier_test", &sql.DB{}, "test")
+
+	n := notifier.New(rm, &mockWeather{}, &mockEmail{}, l, "0 0 * * * *", "0 0 9 * * *", m)
+	now := time.Date(2025, 7, 1, 9, 30, 15, 0, time.Local)
+
+	runs, err := n.Schedule(context.Background(), now)
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.ScheduledRun{
+		{Frequency: "hourly", Next: time.Date(2025, 7, 1, 10, 0, 0, 0, time.Local), Cities: []string{"Kyiv", "Lviv"}},
+		{Frequency: "daily", Next: time.Date(2025, 7, 2, 9, 0, 0, 0, time.Local), Cities: []string{"Odesa"}},
+	}, runs)
+}
+
+func Test_schedule_BadSpec(t *testing.T) {
+	l, err := logger.NewLogger("logs/subscriptions_test.log", "notifier_test")
+	require.NoError(t, err)
+
+	m := metrics.NewMetrics("notifier_test", &sql.DB{}, "test")
+
+	n := notifier.New(&mockRepo{}, &mockWeather{}, &mockEmail{}, l, "every hour", "0 0 9 * * *", m)
+	_, err = n.Schedule(context.Background(), time.Now())
+	assert.Error(t, err)
+}
@@ -83,9 +83,18 @@ func (p *Producer) SendWeather(
 	event := messaging.WeatherNotifyEvent{
 		Email: email,
 		Weather: messaging.Weather{
-			Temperature: data.Temperature,
-			City:        data.City,
-			Description: data.Condition,
+			Temperature:   data.Temperature,
+			City:          data.City,
+			Description:   data.Condition,
+			ConditionCode: data.ConditionCode,
+			Humidity:      data.Humidity,
+			WindSpeed:     data.WindSpeed,
+			WindDirection: data.WindDirection,
+			Pressure:      data.Pressure,
+			FeelsLike:     data.FeelsLike,
+			ObservedAt:    data.ObservedAt,
+			Provider:      data.Provider,
+			Units:         data.Units,
 		},
 	}
 
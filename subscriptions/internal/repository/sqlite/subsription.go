@@ -61,16 +61,22 @@ func (r *SubscriptionRepository) Create(
 		return http.ErrSubscriptionExists
 	}
 
+	units := data.Units
+	if units == "" {
+		units = models.UnitsMetric
+	}
+
 	r.log.Info().Ctx(ctx).
 		Str("email", data.Email).
 		Str("city", data.City).
+		Str("units", units).
 		Msg("inserting new subscription record")
 
 	_, err = r.DB.ExecContext(ctx,
 		`INSERT INTO subscriptions 
-		    (email, city, token, confirmed, unsubscribed, created_at, frequency, last_sent)
-		 VALUES (?, ?, ?, 0, 0, ?, ?, null)`,
-		data.Email, data.City, token, time.Now(), data.Frequency,
+		    (email, city, token, confirmed, unsubscribed, created_at, frequency, units, last_sent)
+		 VALUES (?, ?, ?, 0, 0, ?, ?, ?, null)`,
+		data.Email, data.City, token, time.Now(), data.Frequency, units,
 	)
 	dur := time.Since(start)
 	if err != nil {
@@ -185,7 +191,7 @@ func (r *SubscriptionRepository) GetConfirmedByFrequency(
 	r.log.Debug().Ctx(ctx).Str("frequency", frequency).Msg("querying confirmed subscriptions by frequency")
 
 	rows, err := r.DB.QueryContext(ctx, `
-		SELECT id, email, city, frequency, last_sent
+		SELECT id, email, city, frequency, units, last_sent
 		FROM subscriptions
 		WHERE confirmed = 1 AND unsubscribed = 0 AND frequency = ?`, frequency,
 	)
@@ -216,7 +222,7 @@ func (r *SubscriptionRepository) GetConfirmedByFrequency(
 		var sub models.Subscription
 		var lastSent sql.NullTime
 
-		if err := rows.Scan(&sub.ID, &sub.Email, &sub.City, &sub.Frequency, &lastSent); err != nil {
+		if err := rows.Scan(&sub.ID, &sub.Email, &sub.City, &sub.Frequency, &sub.Units, &lastSent); err != nil {
 			r.log.Error().Err(err).Ctx(ctx).
 				Msg("failed to scan subscription row")
 			r.m.TechnicalErrors.WithLabelValues("db_scan_error", "critical").Inc()
@@ -243,3 +249,57 @@ func (r *SubscriptionRepository) GetConfirmedByFrequency(
 		Msg("retrieved confirmed subscriptions")
 	return subs, nil
 }
+
+// GetCitiesByFrequency retrieves the distinct cities of confirmed, non-unsubscribed subscriptions by frequency.
+func (r *SubscriptionRepository) GetCitiesByFrequency(ctx context.Context, frequency string) ([]string, error) {
+	start := time.Now()
+	r.log.Debug().Ctx(ctx).Str("frequency", frequency).Msg("querying subscribed cities by frequency")
+
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT DISTINCT city
+		FROM subscriptions
+		WHERE confirmed = 1 AND unsubscribed = 0 AND frequency = ?
+		ORDER BY city`, frequency,
+	)
+	if err != nil {
+		r.log.Error().Err(err).Ctx(ctx).
+			Str("frequency", frequency).
+			Msg("failed to query cities by frequency")
+		r.m.TechnicalErrors.WithLabelValues("db_query_error", "critical").Inc()
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			r.log.Error().Err(err).Ctx(ctx).
+				Str("frequency", frequency).
+				Msg("failed to close rows after query")
+			r.m.TechnicalErrors.WithLabelValues("db_rows_close_error", "critical").Inc()
+		}
+	}(rows)
+
+	var cities []string
+	for rows.Next() {
+		var city string
+		if err := rows.Scan(&city); err != nil {
+			r.log.Error().Err(err).Ctx(ctx).
+				Msg("failed to scan city row")
+			r.m.TechnicalErrors.WithLabelValues("db_scan_error", "critical").Inc()
+			return nil, err
+		}
+		cities = append(cities, city)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error().Err(err).Ctx(ctx).
+			Msg("row iteration error")
+		r.m.TechnicalErrors.WithLabelValues("db_rows_error", "critical").Inc()
+		return nil, err
+	}
+
+	r.log.Info().Ctx(ctx).
+		Str("frequency", frequency).
+		Int("count", len(cities)).
+		Dur("duration", time.Since(start)).
+		Msg("retrieved subscribed cities")
+	return cities, nil
+}
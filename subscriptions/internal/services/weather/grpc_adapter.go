@@ -2,6 +2,7 @@ package weather
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
@@ -28,13 +29,14 @@ func NewGrpcWeatherAdapter(
 	return &GrpcWeatherAdapter{inner: client, logger: logger, m: m}
 }
 
-// GetByCity retrieves weather data for a given city via gRPC, logging and recording metrics.
-func (g *GrpcWeatherAdapter) GetByCity(ctx context.Context, city string) (models.WeatherData, error) {
+// GetByCity retrieves weather data for a given city in the given units via gRPC,
+// logging and recording metrics.
+func (g *GrpcWeatherAdapter) GetByCity(ctx context.Context, city, units string) (models.WeatherData, error) {
 	start := time.Now()
 
 	g.logger.Debug().Ctx(ctx).Str("city", city).Msg("calling weather service gRPC method GetByCity")
 
-	resp, err := g.inner.GetByCity(ctx, &weatherpb.WeatherRequest{City: city})
+	resp, err := g.inner.GetByCity(ctx, &weatherpb.WeatherRequest{City: city, Units: units})
 	dur := time.Since(start)
 
 	if err != nil {
@@ -52,9 +54,76 @@ func (g *GrpcWeatherAdapter) GetByCity(ctx context.Context, city string) (models
 		Dur("duration", dur).
 		Msg("weather gRPC call succeeded")
 
-	return models.WeatherData{
-		City:        resp.City,
-		Temperature: resp.Temperature,
-		Condition:   resp.Condition,
-	}, nil
+	return toWeatherData(resp), nil
+}
+
+// GetByCities retrieves weather for several cities in a single gRPC call. Cities the
+// weather service could not serve are logged and left out of the returned map.
+func (g *GrpcWeatherAdapter) GetByCities(
+	ctx context.Context,
+	cities []string,
+	units string,
+) (map[string]models.WeatherData, error) {
+	start := time.Now()
+
+	g.logger.Debug().Ctx(ctx).Strs("cities", cities).Msg("calling weather service gRPC method GetByCities")
+
+	resp, err := g.inner.GetByCities(ctx, &weatherpb.CitiesRequest{Cities: cities, Units: units})
+	dur := time.Since(start)
+
+	if err != nil {
+		g.logger.Error().Err(err).Ctx(ctx).
+			Int("cities", len(cities)).
+			Dur("duration", dur).
+			Msg("weather batch gRPC call failed")
+		return nil, err
+	}
+
+	for city, reason := range resp.Errors {
+		g.logger.Warn().Ctx(ctx).
+			Str("city", city).
+			Str("reason", reason).
+			Msg("weather service could not serve city")
+	}
+
+	g.logger.Info().Ctx(ctx).
+		Int("cities", len(cities)).
+		Int("failed", len(resp.Errors)).
+		Dur("duration", dur).
+		Msg("weather batch gRPC call succeeded")
+
+	weather := make(map[string]models.WeatherData, len(resp.Weather))
+	for city, w := range resp.Weather {
+		weather[city] = toWeatherData(w)
+	}
+	return weather, nil
+}
+
+// conditionCode renders a canonical condition as its lowercase name, such as
+// "partly_cloudy"; an unspecified condition is empty.
+func conditionCode(c weatherpb.Condition) string {
+	if c == weatherpb.Condition_CONDITION_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(c.String(), "CONDITION_"))
+}
+
+func toWeatherData(resp *weatherpb.WeatherResponse) models.WeatherData {
+	data := models.WeatherData{
+		City:          resp.City,
+		Temperature:   resp.Temperature,
+		Condition:     resp.Condition,
+		ConditionCode: conditionCode(resp.ConditionCode),
+		Humidity:      int(resp.Humidity),
+		WindSpeed:     resp.WindSpeed,
+		WindDirection: int(resp.WindDirection),
+		Pressure:      resp.Pressure,
+		FeelsLike:     resp.FeelsLike,
+		Provider:      resp.Provider,
+		Units:         resp.Units,
+	}
+	if resp.GetObservedAt() != nil {
+		data.ObservedAt = resp.GetObservedAt().AsTime()
+	}
+	return data
 }
@@ -0,0 +1,47 @@
+package weather_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+	"github.com/Nazarious-ucu/weather-subscription-api/subscriptions/internal/services/weather"
+)
+
+type stubWeatherClient struct {
+	weatherpb.WeatherServiceClient
+	resp *weatherpb.WeatherResponse
+}
+
+func (s stubWeatherClient) GetByCity(
+	context.Context,
+	*weatherpb.WeatherRequest,
+	...grpc.CallOption,
+) (*weatherpb.WeatherResponse, error) {
+	return s.resp, nil
+}
+
+func TestGrpcWeatherAdapter_ObservedAt(t *testing.T) {
+	observed := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	adapter := weather.NewGrpcWeatherAdapter(stubWeatherClient{resp: &weatherpb.WeatherResponse{
+		City:       "Kyiv",
+		ObservedAt: timestamppb.New(observed),
+	}}, zerolog.Nop(), nil)
+	data, err := adapter.GetByCity(context.Background(), "Kyiv", "")
+	require.NoError(t, err)
+	assert.True(t, observed.Equal(data.ObservedAt))
+
+	adapter = weather.NewGrpcWeatherAdapter(stubWeatherClient{resp: &weatherpb.WeatherResponse{City: "Kyiv"}},
+		zerolog.Nop(), nil)
+	data, err = adapter.GetByCity(context.Background(), "Kyiv", "")
+	require.NoError(t, err)
+	assert.True(t, data.ObservedAt.IsZero(), "a missing timestamp stays unset rather than becoming the epoch")
+}
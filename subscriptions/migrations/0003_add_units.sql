@@ -0,0 +1,3 @@
+-- +goose Up
+ALTER TABLE subscriptions ADD COLUMN units TEXT NOT NULL DEFAULT 'metric';
+-- +goose Down
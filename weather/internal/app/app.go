@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"database/sql"
 	"net"
 	"net/http"
 	"time"
@@ -13,13 +14,20 @@ import (
 	"github.com/rs/zerolog"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/subs"
 	"github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/config"
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/repository/sqlite"
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/cache"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/cities"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/history"
 	loggerT "github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/logger"
 	metricsSvc "github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/metrics"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/warmup"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/watch"
 	serviceWeather "github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather"
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather/decorators"
 	fLogger "github.com/Nazarious-ucu/weather-subscription-api/weather/pkg/logger"
@@ -34,6 +42,8 @@ type ServiceContainer struct {
 	Router     *gin.Engine
 	Srv        *http.Server
 	fileLogger *zap.Logger
+	warmupConn *grpc.ClientConn
+	historyDB  *sql.DB
 }
 
 // App ties together config, logger, and metrics for startup/shutdown.
@@ -69,6 +79,8 @@ func (a *App) Start(ctx context.Context) error {
 	// Mount weather HTTP endpoint
 	weatherHandler := http2.NewHandler(srvContainer.WeatherService)
 	srvContainer.Router.GET("/weather", weatherHandler.GetWeather)
+	srvContainer.Router.GET("/weather/coordinates", weatherHandler.GetWeatherByCoordinates)
+	srvContainer.Router.GET("/weather/forecast", weatherHandler.GetForecast)
 
 	a.l.Info().
 		Str("grpc_port", a.cfg.Server.GrpcPort).
@@ -98,8 +110,18 @@ func (a *App) Shutdown(srvContainer ServiceContainer) error {
 		}
 	}(srvContainer.fileLogger)
 
+	if srvContainer.warmupConn != nil {
+		if err := srvContainer.warmupConn.Close(); err != nil {
+			a.l.Error().Err(err).Msg("failed to close subscriptions connection")
+		}
+	}
+
 	a.l.Info().Msg("shutting down gRPC server")
 	srvContainer.GrpcServer.GracefulStop()
+
+	if err := srvContainer.historyDB.Close(); err != nil {
+		a.l.Error().Err(err).Msg("failed to close history database")
+	}
 	a.l.Info().Msg("shutdown complete")
 	return nil
 }
@@ -116,45 +138,174 @@ func (a *App) init(ctx context.Context) ServiceContainer {
 		a.l.Error().Err(err).Msg("failed to create file logger")
 	}
 
+	generic := a.genericProviders()
+
 	// HTTP client logging
 	roundTripper := loggerT.NewRoundTripper(fileLogger)
+	if a.cfg.Cassette.Mode != "" {
+		cassette, err := loggerT.NewCassette(a.cfg.Cassette.Dir, a.cfg.Cassette.Mode, roundTripper.Proxy,
+			genericAuthNames(generic)...)
+		if err != nil {
+			a.l.Fatal().Err(err).Msg("failed to open provider cassette")
+		}
+		roundTripper.Proxy = cassette
+		a.l.Info().Str("mode", a.cfg.Cassette.Mode).Str("dir", a.cfg.Cassette.Dir).Msg("provider calls go through a cassette")
+	}
 	httpLogClient := &http.Client{Transport: roundTripper}
 
 	// Weather service with circuit breakers
 	breakerCfg := serviceWeather.BreakerConfig{
-		TimeInterval: time.Duration(a.cfg.Breaker.TimeInterval) * time.Second,
-		TimeTimeOut:  time.Duration(a.cfg.Breaker.TimeTimeOut) * time.Second,
-		RepeatNumber: a.cfg.Breaker.RepeatNumber,
+		TimeInterval:  time.Duration(a.cfg.Breaker.TimeInterval) * time.Second,
+		TimeTimeOut:   time.Duration(a.cfg.Breaker.TimeTimeOut) * time.Second,
+		RepeatNumber:  a.cfg.Breaker.RepeatNumber,
+		OnStateChange: a.m.ObserveBreakerTransition,
+		OnStart:       a.m.ObserveBreakerState,
 	}
-	openWeather := serviceWeather.NewBreakerClient("OpenWeather", breakerCfg, a.l,
-		serviceWeather.NewClientOpenWeatherMap(
-			a.cfg.OpenWeatherMapAPIKey,
-			a.cfg.OpenWeatherMapURL,
-			httpLogClient,
-			a.l),
-	)
-	weatherAPI := serviceWeather.NewBreakerClient("WeatherAPI", breakerCfg, a.l,
-		serviceWeather.NewClientWeatherAPI(a.cfg.WeatherAPIKey, a.cfg.WeatherAPIURL, httpLogClient, a.l),
+
+	// Retries run inside the breakers, so a lookup only counts as one failure
+	// once every attempt has failed
+	retryCfg := func(provider string) serviceWeather.RetryConfig {
+		return serviceWeather.RetryConfig{
+			Attempts:  a.cfg.Retry.Attempts[provider],
+			BaseDelay: time.Duration(a.cfg.Retry.BaseDelay[provider]) * time.Millisecond,
+			MaxDelay:  time.Duration(a.cfg.Retry.MaxDelay[provider]) * time.Millisecond,
+		}
+	}
+
+	// Call budgets are charged per attempt; a spent budget never trips a breaker
+	quotaCounter := cache.NewRedisQuota(redisClient)
+	quotaCfg := func(provider string) serviceWeather.QuotaConfig {
+		return serviceWeather.QuotaConfig{
+			PerMinute:   a.cfg.Quota.PerMinute[provider],
+			PerDay:      a.cfg.Quota.PerDay[provider],
+			Cost:        a.cfg.Quota.Cost[provider],
+			OnRemaining: a.m.ObserveQuotaRemaining,
+			OnCall:      a.m.ObserveCallCost,
+		}
+	}
+
+	// Every provider is budgeted, retried and guarded by a breaker, in that order
+	// from the inside out, so every attempt is charged against the budget
+	provider := func(name string, raw serviceWeather.Client) serviceWeather.Client {
+		return serviceWeather.NewBreakerClient(name, breakerCfg, a.l,
+			serviceWeather.NewRetryClient(name, retryCfg(name), a.l,
+				serviceWeather.NewQuotaClient(name, quotaCfg(name), quotaCounter, a.l, raw),
+			),
+		)
+	}
+
+	// A snapshot replaces every real provider, so the service runs offline
+	var (
+		clients []serviceWeather.Client
+		fake    *serviceWeather.ClientFake
 	)
+	if a.cfg.Fake.Snapshot != "" {
+		snapshot, err := serviceWeather.LoadFakeSnapshot(a.cfg.Fake.Snapshot)
+		if err != nil {
+			a.l.Fatal().Err(err).Msg("failed to load fake provider snapshot")
+		}
+		fake = serviceWeather.NewClientFake(snapshot)
+		clients = append(clients, provider(fake.Name(), fake))
+	} else {
+		clients = a.weatherClients(httpLogClient, generic, provider)
+	}
 
-	weatherBit := serviceWeather.NewBreakerClient("WeatherBit", breakerCfg, a.l,
-		serviceWeather.NewClientWeatherBit(
-			a.cfg.WeatherBitAPIKey,
-			a.cfg.WeatherBitURL,
-			httpLogClient,
+	strategy, err := serviceWeather.ParseStrategy(a.cfg.Strategy)
+	if err != nil {
+		a.l.Error().Err(err).Msg("falling back to failover strategy")
+		strategy = serviceWeather.StrategyFailover
+	}
+	providers := serviceWeather.NewService(a.l, serviceWeather.StrategyConfig{
+		Strategy:      strategy,
+		HedgeDelay:    time.Duration(a.cfg.Hedge.Delay) * time.Millisecond,
+		HedgeAdaptive: a.cfg.Hedge.Adaptive,
+		OnHedgeResult: a.m.ObserveHedgeResult,
+		Weights:       a.cfg.ProviderWeights,
+	}, clients...)
+
+	cityResolver, err := cities.NewResolver()
+	if err != nil {
+		a.l.Fatal().Err(err).Msg("failed to load city dataset")
+	}
+
+	// Every provider fetch is kept as an observation for GetHistory
+	historyDB, err := sqlite.Open(ctx, a.cfg.History.DBPath, a.cfg.History.MigrationsDir)
+	if err != nil {
+		a.l.Fatal().Err(err).Msg("failed to open history database")
+	}
+	historyService := history.NewService(sqlite.NewObservationRepository(historyDB, a.l), cityResolver, a.l)
+	go historyService.RunRetention(ctx, time.Duration(a.cfg.History.Retention)*24*time.Hour, time.Hour)
+	rawService := decorators.NewRecordingService(providers, cityResolver, historyService, a.l)
+
+	// Two-tier caches (in-process LRU in front of Redis) with metrics
+	cacheCollector := metricsSvc.NewPromCollector()
+	localTTL := time.Duration(a.cfg.Local.TTL) * time.Second
+	cacheMetrics := cache.NewMetricsDecorator[models.CacheEntry[models.WeatherData]](
+		cache.NewTiered[models.CacheEntry[models.WeatherData]](
+			cache.NewLRU[models.CacheEntry[models.WeatherData]](a.cfg.Local.Size, localTTL),
+			cache.NewRedisClient[models.CacheEntry[models.WeatherData]](redisClient,
+				a.l,
+				time.Duration(a.cfg.Redis.LiveTime)*time.Hour),
 			a.l,
 		),
+		cacheCollector,
 	)
-	rawService := serviceWeather.NewService(a.l, weatherAPI, openWeather, weatherBit)
-
-	// Metrics for cache and service
-	cacheMetrics := cache.NewMetricsDecorator[models.WeatherData](
-		cache.NewRedisClient[models.WeatherData](redisClient,
+	forecastCacheMetrics := cache.NewMetricsDecorator[models.CacheEntry[models.Forecast]](
+		cache.NewTiered[models.CacheEntry[models.Forecast]](
+			cache.NewLRU[models.CacheEntry[models.Forecast]](a.cfg.Local.Size, localTTL),
+			cache.NewRedisClient[models.CacheEntry[models.Forecast]](redisClient,
+				a.l,
+				time.Duration(a.cfg.Redis.LiveTime)*time.Hour),
 			a.l,
-			time.Duration(a.cfg.Redis.LiveTime)*time.Hour),
-		metricsSvc.NewPromCollector(),
+		),
+		cacheCollector,
+	)
+	updatesHub := watch.NewHub()
+	cacheTTL := decorators.CacheTTL{
+		Soft: time.Duration(a.cfg.Redis.SoftTTL) * time.Minute,
+		Hard: time.Duration(a.cfg.Redis.HardTTL) * time.Minute,
+	}
+	weatherService := decorators.NewCachedService(
+		rawService,
+		cityResolver,
+		cacheMetrics,
+		forecastCacheMetrics,
+		updatesHub,
+		cacheTTL,
+		a.l,
 	)
-	weatherService := decorators.NewCachedService(rawService, cacheMetrics, a.l)
+
+	if fake != nil && a.cfg.Fake.Preload {
+		if err := fake.Preload(ctx, weatherService.Seed); err != nil {
+			a.l.Error().Err(err).Msg("failed to preload cache from fake provider snapshot")
+		} else {
+			a.l.Info().Str("snapshot", a.cfg.Fake.Snapshot).Msg("cache preloaded from fake provider snapshot")
+		}
+	}
+
+	// Cache warm-up ahead of notifier runs
+	var warmupConn *grpc.ClientConn
+	if a.cfg.Warmup.SubscriptionsAddr != "" {
+		warmupConn, err = grpc.NewClient(a.cfg.Warmup.SubscriptionsAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			a.l.Error().Err(err).Msg("failed to create subscriptions client, cache warm-up disabled")
+		} else {
+			scheduler := warmup.New(
+				warmup.NewSubscriptionsSource(subs.NewSubscriptionServiceClient(warmupConn)),
+				weatherService,
+				warmup.Config{
+					Lead:       time.Duration(a.cfg.Warmup.Lead) * time.Second,
+					Rate:       a.cfg.Warmup.Rate,
+					RetryAfter: time.Duration(a.cfg.Warmup.RetryAfter) * time.Second,
+					OnWarmed:   a.m.ObserveWarmup,
+				},
+				a.l,
+			)
+			go scheduler.Run(ctx)
+		}
+	}
 
 	// Setup Gin router
 	router := gin.New()
@@ -162,10 +313,24 @@ func (a *App) init(ctx context.Context) ServiceContainer {
 
 	// Setup gRPC server with metrics interceptors
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(a.m.UnaryInterceptor()),
+		grpc.ChainUnaryInterceptor(
+			a.m.UnaryInterceptor(),
+			grpc2.AdminAuth(a.cfg.Server.AdminToken,
+				weather.AdminService_ServiceDesc.ServiceName,
+				weather.CacheAdminService_ServiceDesc.ServiceName,
+			),
+		),
 		grpc.StreamInterceptor(a.m.StreamInterceptor()),
 	)
-	weather.RegisterWeatherServiceServer(grpcServer, grpc2.NewWeatherGRPCServer(weatherService))
+	weather.RegisterWeatherServiceServer(grpcServer, grpc2.NewWeatherGRPCServer(
+		weatherService,
+		updatesHub,
+		cityResolver,
+		historyService,
+		time.Duration(a.cfg.Server.WatchRefresh)*time.Second,
+	))
+	weather.RegisterAdminServiceServer(grpcServer, grpc2.NewAdminGRPCServer(providers))
+	weather.RegisterCacheAdminServiceServer(grpcServer, grpc2.NewCacheAdminGRPCServer(weatherService))
 
 	// HTTP server config (unused but prepared)
 	httpServer := &http.Server{
@@ -196,11 +361,86 @@ func (a *App) init(ctx context.Context) ServiceContainer {
 		Router:         router,
 		Srv:            httpServer,
 		fileLogger:     fileLogger,
+		warmupConn:     warmupConn,
+		historyDB:      historyDB,
 	}
 
 	return srvContainer
 }
 
+// weatherClients builds the real providers, each wrapped by provider.
+func (a *App) weatherClients(
+	httpClient *http.Client,
+	generic []serviceWeather.GenericConfig,
+	provider func(name string, raw serviceWeather.Client) serviceWeather.Client,
+) []serviceWeather.Client {
+	// Keyed providers are used when their key is set; Open-Meteo needs none and
+	// comes last, so the service always has at least one provider
+	var clients []serviceWeather.Client
+	if a.cfg.WeatherAPIKey != "" {
+		clients = append(clients, provider("WeatherAPI", serviceWeather.NewClientWeatherAPI(
+			a.cfg.WeatherAPIKey,
+			a.cfg.WeatherAPIURL,
+			a.cfg.WeatherAPIForecastURL,
+			httpClient,
+			a.l,
+		)))
+	}
+	if a.cfg.OpenWeatherMapAPIKey != "" {
+		clients = append(clients, provider("OpenWeather", serviceWeather.NewClientOpenWeatherMap(
+			a.cfg.OpenWeatherMapAPIKey,
+			a.cfg.OpenWeatherMapURL,
+			a.cfg.OpenWeatherMapForecastURL,
+			httpClient,
+			a.l,
+		)))
+	}
+	if a.cfg.WeatherBitAPIKey != "" {
+		clients = append(clients, provider("WeatherBit", serviceWeather.NewClientWeatherBit(
+			a.cfg.WeatherBitAPIKey,
+			a.cfg.WeatherBitURL,
+			a.cfg.WeatherBitForecastURL,
+			httpClient,
+			a.l,
+		)))
+	}
+	for _, cfg := range generic {
+		clients = append(clients, provider(cfg.Name, serviceWeather.NewClientGeneric(cfg, httpClient, a.l)))
+	}
+	clients = append(clients, provider("OpenMeteo", serviceWeather.NewClientOpenMeteo(
+		a.cfg.OpenMeteoURL,
+		a.cfg.OpenMeteoGeocodingURL,
+		httpClient,
+		a.l,
+	)))
+	return clients
+}
+
+// genericProviders loads the providers described by configuration, if any.
+// A fake snapshot replaces them along with the built-in providers.
+func (a *App) genericProviders() []serviceWeather.GenericConfig {
+	if a.cfg.GenericProvidersFile == "" || a.cfg.Fake.Snapshot != "" {
+		return nil
+	}
+	generic, err := serviceWeather.LoadGenericConfigs(a.cfg.GenericProvidersFile)
+	if err != nil {
+		a.l.Fatal().Err(err).Msg("failed to load generic providers")
+	}
+	return generic
+}
+
+// genericAuthNames lists the parameters and headers generic providers take
+// their keys in, for the cassette to redact.
+func genericAuthNames(generic []serviceWeather.GenericConfig) []string {
+	var names []string
+	for _, cfg := range generic {
+		if cfg.Auth.In != "" {
+			names = append(names, cfg.Auth.Name)
+		}
+	}
+	return names
+}
+
 func newRedisConnection(connString string, dbType int) *redis.Client {
 	return redis.NewClient(&redis.Options{Addr: connString, DB: dbType})
 }
@@ -1,12 +1,21 @@
 package config
 
-import "github.com/kelseyhightower/envconfig"
+import (
+	"fmt"
+
+	"github.com/kelseyhightower/envconfig"
+)
 
 type Server struct {
 	Host        string `envconfig:"WEATHER_SERVER_HOST" default:"0.0.0.0"`
 	GrpcPort    string `envconfig:"WEATHER_SERVER_GRPC_PORT" default:"50052"`
 	HTTPPort    string `envconfig:"WEATHER_SERVER_HTTP_PORT" default:"8082"`
 	ReadTimeout int    `envconfig:"WEATHER_SERVER_TIMEOUT" default:"10"`
+
+	WatchRefresh int `envconfig:"WEATHER_WATCH_REFRESH" default:"30"` // seconds between WatchCity cache reads
+
+	// AdminToken guards the admin APIs; while empty they reject every call.
+	AdminToken string `envconfig:"WEATHER_ADMIN_TOKEN"`
 }
 
 type Breaker struct {
@@ -15,26 +24,118 @@ type Breaker struct {
 	RepeatNumber uint32 `envconfig:"BREAKER_REPEAT_NUM" default:"5"`
 }
 
+type Hedge struct {
+	Delay    int  `envconfig:"WEATHER_HEDGE_DELAY" default:"300"` // milliseconds before racing the next provider
+	Adaptive bool `envconfig:"WEATHER_HEDGE_ADAPTIVE" default:"false"`
+}
+
+// Quota budgets provider calls, e.g. "OpenWeather:60,WeatherBit:50". Unlisted
+// providers are unlimited. Counts are kept in Redis and shared by all replicas.
+type Quota struct {
+	PerMinute map[string]int64   `envconfig:"WEATHER_QUOTA_PER_MINUTE"`
+	PerDay    map[string]int64   `envconfig:"WEATHER_QUOTA_PER_DAY"`
+	Cost      map[string]float64 `envconfig:"WEATHER_QUOTA_COST"` // per call, reported as provider_calls_cost_total
+}
+
+// Retry configures per-provider retries of transient failures, e.g.
+// "WeatherAPI:3,OpenWeather:2". Delays are in milliseconds; unlisted providers
+// make a single attempt and use the default delays.
+type Retry struct {
+	Attempts  map[string]int `envconfig:"WEATHER_RETRY_ATTEMPTS" default:"WeatherAPI:3,OpenWeather:3,WeatherBit:2,OpenMeteo:3"`
+	BaseDelay map[string]int `envconfig:"WEATHER_RETRY_BASE_DELAY"` // 100 when unlisted
+	MaxDelay  map[string]int `envconfig:"WEATHER_RETRY_MAX_DELAY"`  // 2000 when unlisted
+}
+
 type Redis struct {
 	Host     string `envconfig:"REDIS_HOST" default:"localhost"`
 	Port     string `envconfig:"REDIS_PORT" default:"6379"`
 	DbType   int    `envconfig:"REDIS_DB_TYPE" required:"true"`
 	LiveTime int    `envconfig:"REDIS_LIVE_TIME" default:"1"`
+
+	// Minutes after which a cached entry is served stale while refreshing in the
+	// background (soft), and after which it is refetched before being served (hard).
+	// Past the hard TTL it is still kept for LiveTime hours as a fallback for provider outages.
+	SoftTTL int `envconfig:"REDIS_SOFT_TTL" default:"5"`
+	HardTTL int `envconfig:"REDIS_HARD_TTL" default:"15"`
+}
+
+// LocalCache is the in-process tier kept in front of Redis.
+type LocalCache struct {
+	Size int `envconfig:"LOCAL_CACHE_SIZE" default:"1000"` // entries per cache
+	TTL  int `envconfig:"LOCAL_CACHE_TTL" default:"30"`    // seconds
+}
+
+// Warmup refreshes the cache for subscribed cities shortly before each notifier
+// run. It is off while SubscriptionsAddr is empty.
+type Warmup struct {
+	SubscriptionsAddr string  `envconfig:"WARMUP_SUBSCRIPTIONS_ADDR"`       // subscriptions gRPC address, host:port
+	Lead              int     `envconfig:"WARMUP_LEAD" default:"60"`        // seconds before a run; keep below REDIS_SOFT_TTL
+	Rate              float64 `envconfig:"WARMUP_RATE" default:"2"`         // provider fetches per second
+	RetryAfter        int     `envconfig:"WARMUP_RETRY_AFTER" default:"60"` // seconds between attempts to fetch the schedule
+}
+
+// History is the local store of every observation fetched from providers.
+type History struct {
+	DBPath        string `envconfig:"HISTORY_DB_PATH" default:"weather-history.db"`
+	MigrationsDir string `envconfig:"HISTORY_MIGRATIONS_DIR" default:"./migrations"`
+	Retention     int    `envconfig:"HISTORY_RETENTION" default:"90"` // days observations are kept
+}
+
+// Cassette records provider calls to Dir or replays them from it, so tests and
+// demos run against real responses without the network. Mode is "record",
+// "replay" or empty for live calls.
+type Cassette struct {
+	Dir  string `envconfig:"WEATHER_CASSETTE_DIR" default:"./cassettes"`
+	Mode string `envconfig:"WEATHER_CASSETTE_MODE"`
+}
+
+// Fake replaces every provider with one serving weather from a snapshot file,
+// so the service runs without keys or network; see weather.FakeSnapshot.
+type Fake struct {
+	Snapshot string `envconfig:"WEATHER_FAKE_SNAPSHOT"`
+	// Preload fills the cache with the snapshot at startup.
+	Preload bool `envconfig:"WEATHER_FAKE_PRELOAD"`
 }
 
 type Config struct {
-	WeatherAPIKey string `envconfig:"WEATHER_API_KEY" required:"true"`
-	WeatherAPIURL string `envconfig:"WEATHER_API_URL" required:"true"`
+	// Keyed providers are only used when their key is set; Open-Meteo needs none
+	// and is always used, so the service runs without any secrets.
+	WeatherAPIKey         string `envconfig:"WEATHER_API_KEY"`
+	WeatherAPIURL         string `envconfig:"WEATHER_API_URL" default:"https://api.weatherapi.com/v1/current.json"`
+	WeatherAPIForecastURL string `envconfig:"WEATHER_API_FORECAST_URL" default:"https://api.weatherapi.com/v1/forecast.json"`
+
+	OpenWeatherMapAPIKey      string `envconfig:"OPEN_WEATHER_MAP_API_KEY"`
+	OpenWeatherMapURL         string `envconfig:"OPEN_WEATHER_MAP_URL" default:"https://api.openweathermap.org/data/2.5/weather"`
+	OpenWeatherMapForecastURL string `envconfig:"OPEN_WEATHER_MAP_FORECAST_URL" default:"https://api.openweathermap.org/data/2.5/forecast"`
+
+	WeatherBitAPIKey      string `envconfig:"WEATHER_BIT_API_KEY"`
+	WeatherBitURL         string `envconfig:"WEATHER_BIT_URL" default:"https://api.weatherbit.io/v2.0/current"`
+	WeatherBitForecastURL string `envconfig:"WEATHER_BIT_FORECAST_URL" default:"https://api.weatherbit.io/v2.0/forecast/daily"`
+
+	OpenMeteoURL          string `envconfig:"OPEN_METEO_URL" default:"https://api.open-meteo.com/v1/forecast"`
+	OpenMeteoGeocodingURL string `envconfig:"OPEN_METEO_GEOCODING_URL" default:"https://geocoding-api.open-meteo.com/v1/search"`
 
-	OpenWeatherMapAPIKey string `envconfig:"OPEN_WEATHER_MAP_API_KEY" required:"true"`
-	OpenWeatherMapURL    string `envconfig:"OPEN_WEATHER_MAP_URL" required:"true"`
+	// GenericProvidersFile is a YAML or JSON file of providers described by
+	// configuration rather than code; see weather.GenericConfig.
+	GenericProvidersFile string `envconfig:"WEATHER_GENERIC_PROVIDERS"`
 
-	WeatherBitAPIKey string `envconfig:"WEATHER_BIT_API_KEY" required:"true"`
-	WeatherBitURL    string `envconfig:"WEATHER_BIT_URL" required:"true"`
+	// Strategy is how providers are combined: "failover" (default), "aggregate" or "hedge".
+	Strategy string `envconfig:"WEATHER_STRATEGY" default:"failover"`
+	// ProviderWeights are manual priorities such as "WeatherAPI:3,OpenWeather:2";
+	// unlisted providers weigh 1. The chain is reordered by health and latency on top.
+	ProviderWeights map[string]float64 `envconfig:"WEATHER_PROVIDER_WEIGHTS"`
 
-	Server  Server
-	Breaker Breaker
-	Redis   Redis
+	Server   Server
+	Breaker  Breaker
+	Hedge    Hedge
+	Quota    Quota
+	Retry    Retry
+	Redis    Redis
+	Local    LocalCache
+	Warmup   Warmup
+	History  History
+	Cassette Cassette
+	Fake     Fake
 
 	LogsPath string `envconfig:"LOGS_PATH" default:"./log/weather-subscription-api.log"`
 }
@@ -44,9 +145,41 @@ func NewConfig() (*Config, error) {
 	if err := envconfig.Process("", &cfg); err != nil {
 		return nil, err
 	}
+	positive := []struct {
+		env   string
+		value int
+	}{
+		{"WEATHER_WATCH_REFRESH", cfg.Server.WatchRefresh},
+		{"WARMUP_RETRY_AFTER", cfg.Warmup.RetryAfter},
+		{"LOCAL_CACHE_SIZE", cfg.Local.Size},
+	}
+	for _, p := range positive {
+		if p.value <= 0 {
+			return nil, fmt.Errorf("%s must be positive, got %d", p.env, p.value)
+		}
+	}
 	return &cfg, nil
 }
 
 func (c *Config) ServerAddress() string {
 	return c.Server.Host + ":" + c.Server.HTTPPort
 }
+
+// String formats the configuration for logs with the secrets redacted.
+func (c Config) String() string {
+	type plain Config
+	p := plain(c)
+	p.WeatherAPIKey = redact(p.WeatherAPIKey)
+	p.OpenWeatherMapAPIKey = redact(p.OpenWeatherMapAPIKey)
+	p.WeatherBitAPIKey = redact(p.WeatherBitAPIKey)
+	p.Server.AdminToken = redact(p.Server.AdminToken)
+	return fmt.Sprintf("%+v", p)
+}
+
+// redact hides a secret, leaving only whether it is set.
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return "[redacted]"
+}
@@ -0,0 +1,43 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/config"
+)
+
+func TestConfig_StringRedactsSecrets(t *testing.T) {
+	cfg := &config.Config{
+		WeatherAPIKey:        "weatherapi-secret",
+		OpenWeatherMapAPIKey: "openweather-secret",
+		WeatherBitAPIKey:     "weatherbit-secret",
+		Server:               config.Server{AdminToken: "admin-secret", HTTPPort: "8082"},
+	}
+
+	out := fmt.Sprintf("%+v", cfg)
+	assert.NotContains(t, out, "secret")
+	assert.Contains(t, out, "[redacted]")
+	assert.Contains(t, out, "8082")
+}
+
+func TestNewConfig_RejectsNonPositiveValues(t *testing.T) {
+	for _, env := range []string{"WEATHER_WATCH_REFRESH", "WARMUP_RETRY_AFTER", "LOCAL_CACHE_SIZE"} {
+		t.Run(env, func(t *testing.T) {
+			t.Setenv("REDIS_DB_TYPE", "0")
+
+			for _, value := range []string{"0", "-5"} {
+				t.Setenv(env, value)
+				_, err := config.NewConfig()
+				assert.ErrorContains(t, err, env)
+			}
+
+			t.Setenv(env, "15")
+			_, err := config.NewConfig()
+			require.NoError(t, err)
+		})
+	}
+}
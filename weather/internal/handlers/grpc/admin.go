@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+type providerStatusGetter interface {
+	ProviderStatus() []models.ProviderStatus
+}
+
+// AdminGRPCServer serves provider status. It does no authentication of its
+// own; register it behind AdminAuth.
+type AdminGRPCServer struct {
+	weatherpb.UnimplementedAdminServiceServer
+	providers providerStatusGetter
+}
+
+func NewAdminGRPCServer(providers providerStatusGetter) *AdminGRPCServer {
+	return &AdminGRPCServer{providers: providers}
+}
+
+func (s *AdminGRPCServer) GetProviderStatus(
+	_ context.Context,
+	_ *weatherpb.ProviderStatusRequest,
+) (*weatherpb.ProviderStatusResponse, error) {
+	resp := &weatherpb.ProviderStatusResponse{}
+	for _, p := range s.providers.ProviderStatus() {
+		status := &weatherpb.ProviderStatus{
+			Name:                 p.Name,
+			State:                p.State,
+			Requests:             p.Requests,
+			TotalSuccesses:       p.TotalSuccesses,
+			TotalFailures:        p.TotalFailures,
+			ConsecutiveSuccesses: p.ConsecutiveSuccesses,
+			ConsecutiveFailures:  p.ConsecutiveFailures,
+			LastError:            p.LastError,
+			Priority:             uint32(p.Priority),
+			Weight:               p.Weight,
+			Score:                p.Score,
+			Samples:              uint32(p.Samples),
+			SuccessRate:          p.SuccessRate,
+			LatencyP50Ms:         p.LatencyP50Ms,
+			QuotaExhausted:       p.QuotaExhausted,
+		}
+		if !p.LastErrorAt.IsZero() {
+			status.LastErrorAt = timestamppb.New(p.LastErrorAt)
+		}
+		if !p.LastSuccessAt.IsZero() {
+			status.LastSuccessAt = timestamppb.New(p.LastSuccessAt)
+		}
+		resp.Providers = append(resp.Providers, status)
+	}
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AdminAuth rejects calls to the given services unless they carry an
+// "authorization: Bearer <token>" header matching token. With an empty token
+// every call to them is rejected. Calls to other services pass through.
+func AdminAuth(token string, services ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !guarded(info.FullMethod, services) {
+			return handler(ctx, req)
+		}
+		if token == "" {
+			return nil, status.Error(codes.Unauthenticated, "admin API is disabled: no admin token configured")
+		}
+
+		var (
+			got    string
+			bearer bool
+		)
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				got, bearer = strings.CutPrefix(values[0], "Bearer ")
+			}
+		}
+		if !bearer || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid admin token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// guarded reports whether a method such as "/weather.v1.CacheAdminService/PurgeCache"
+// belongs to one of services.
+func guarded(fullMethod string, services []string) bool {
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	for _, s := range services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
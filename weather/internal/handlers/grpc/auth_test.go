@@ -0,0 +1,51 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	grpc2 "github.com/Nazarious-ucu/weather-subscription-api/weather/internal/handlers/grpc"
+)
+
+func TestAdminAuth(t *testing.T) {
+	const (
+		guarded = "/weather.v1.CacheAdminService/PurgeCache"
+		open    = "/weather.v1.WeatherService/GetByCity"
+	)
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		header string
+		want   codes.Code
+	}{
+		{name: "ValidToken", token: "secret", method: guarded, header: "Bearer secret", want: codes.OK},
+		{name: "WrongToken", token: "secret", method: guarded, header: "Bearer guess", want: codes.Unauthenticated},
+		{name: "NoHeader", token: "secret", method: guarded, want: codes.Unauthenticated},
+		{name: "NoScheme", token: "secret", method: guarded, header: "secret", want: codes.Unauthenticated},
+		{name: "NotConfigured", method: guarded, header: "Bearer ", want: codes.Unauthenticated},
+		{name: "OtherService", token: "secret", method: open, want: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.header))
+			}
+
+			interceptor := grpc2.AdminAuth(tt.token, "weather.v1.CacheAdminService")
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
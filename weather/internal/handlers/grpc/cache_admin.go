@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+type cacheAdmin interface {
+	CacheEntries(ctx context.Context, pattern string) ([]models.CachedItem, error)
+	CacheEntry(ctx context.Context, key string) (models.CachedItem, error)
+	PurgeCity(ctx context.Context, city string) ([]string, error)
+	PurgePattern(ctx context.Context, pattern string) ([]string, error)
+	RefreshCity(ctx context.Context, city string) (models.CachedItem, error)
+}
+
+// CacheAdminGRPCServer serves the cache admin API. It does no authentication of
+// its own; register it behind AdminAuth.
+type CacheAdminGRPCServer struct {
+	weatherpb.UnimplementedCacheAdminServiceServer
+	cache cacheAdmin
+}
+
+func NewCacheAdminGRPCServer(cache cacheAdmin) *CacheAdminGRPCServer {
+	return &CacheAdminGRPCServer{cache: cache}
+}
+
+func (s *CacheAdminGRPCServer) ListCacheEntries(
+	ctx context.Context,
+	req *weatherpb.ListCacheEntriesRequest,
+) (*weatherpb.ListCacheEntriesResponse, error) {
+	items, err := s.cache.CacheEntries(ctx, req.Pattern)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "list cache entries: %v", err)
+	}
+
+	resp := &weatherpb.ListCacheEntriesResponse{}
+	for _, item := range items {
+		resp.Entries = append(resp.Entries, toCacheEntryInfo(item))
+	}
+	return resp, nil
+}
+
+func (s *CacheAdminGRPCServer) GetCacheEntry(
+	ctx context.Context,
+	req *weatherpb.GetCacheEntryRequest,
+) (*weatherpb.CacheEntry, error) {
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	item, err := s.cache.CacheEntry(ctx, req.Key)
+	if err != nil {
+		return nil, status.Error(cacheErrorCode(err), err.Error())
+	}
+	return toCacheEntry(item)
+}
+
+func (s *CacheAdminGRPCServer) PurgeCache(
+	ctx context.Context,
+	req *weatherpb.PurgeCacheRequest,
+) (*weatherpb.PurgeCacheResponse, error) {
+	city, pattern := strings.TrimSpace(req.City), strings.TrimSpace(req.Pattern)
+	if (city == "") == (pattern == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of city and pattern is required")
+	}
+
+	var (
+		keys []string
+		err  error
+	)
+	if city != "" {
+		keys, err = s.cache.PurgeCity(ctx, city)
+	} else {
+		keys, err = s.cache.PurgePattern(ctx, pattern)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "purge cache: %v", err)
+	}
+	return &weatherpb.PurgeCacheResponse{Keys: keys}, nil
+}
+
+func (s *CacheAdminGRPCServer) RefreshCity(
+	ctx context.Context,
+	req *weatherpb.RefreshCityRequest,
+) (*weatherpb.CacheEntry, error) {
+	if strings.TrimSpace(req.City) == "" {
+		return nil, status.Error(codes.InvalidArgument, "city is required")
+	}
+
+	item, err := s.cache.RefreshCity(ctx, req.City)
+	if err != nil {
+		return nil, status.Errorf(cacheErrorCode(err), "refresh city: %v", err)
+	}
+	return toCacheEntry(item)
+}
+
+func cacheErrorCode(err error) codes.Code {
+	if errors.Is(err, models.ErrNotCached) {
+		return codes.NotFound
+	}
+	return errorCode(err)
+}
+
+func toCacheEntry(item models.CachedItem) (*weatherpb.CacheEntry, error) {
+	value, err := json.Marshal(item.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode cached value: %v", err)
+	}
+	return &weatherpb.CacheEntry{Info: toCacheEntryInfo(item), Value: string(value)}, nil
+}
+
+func toCacheEntryInfo(item models.CachedItem) *weatherpb.CacheEntryInfo {
+	return &weatherpb.CacheEntryInfo{
+		Key:        item.Key,
+		Kind:       item.Kind,
+		City:       item.City,
+		StoredAt:   timestamppb.New(item.StoredAt),
+		AgeSeconds: int64(item.Age.Seconds()),
+		TtlSeconds: int64(item.TTL.Seconds()),
+		State:      item.State,
+	}
+}
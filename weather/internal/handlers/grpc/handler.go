@@ -2,38 +2,321 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
 )
 
 type weatherGetterService interface {
-	GetByCity(ctx context.Context, city string) (models.WeatherData, error)
+	GetByCity(ctx context.Context, city, lang string) (models.WeatherData, error)
+	GetByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error)
+	GetForecast(ctx context.Context, city string, days int) (models.Forecast, error)
+	GetByCities(ctx context.Context, cities []string, lang string) models.BatchWeather
+}
+
+// cityWatcher delivers weather updates keyed by canonical city ID.
+type cityWatcher interface {
+	Subscribe(cityID string) (<-chan models.WeatherData, func())
+}
+
+type cityCatalog interface {
+	Resolve(input string) models.City
+	Search(prefix string, limit int) []models.City
 }
 
 type WeatherGRPCServer struct {
 	weatherpb.UnimplementedWeatherServiceServer
-	service weatherGetterService
+	service         weatherGetterService
+	watcher         cityWatcher
+	cities          cityCatalog
+	history         historyReader
+	refreshInterval time.Duration
 }
 
-func NewWeatherGRPCServer(service weatherGetterService) *WeatherGRPCServer {
-	return &WeatherGRPCServer{service: service}
+// NewWeatherGRPCServer builds the gRPC server. refreshInterval controls how often a
+// WatchCity stream touches the cache so that expired entries get refetched.
+func NewWeatherGRPCServer(
+	service weatherGetterService,
+	watcher cityWatcher,
+	cities cityCatalog,
+	history historyReader,
+	refreshInterval time.Duration,
+) *WeatherGRPCServer {
+	return &WeatherGRPCServer{
+		service:         service,
+		watcher:         watcher,
+		cities:          cities,
+		history:         history,
+		refreshInterval: refreshInterval,
+	}
 }
 
 func (s *WeatherGRPCServer) GetByCity(
 	ctx context.Context,
 	req *weatherpb.WeatherRequest,
 ) (*weatherpb.WeatherResponse, error) {
-	data, err := s.service.GetByCity(ctx, req.City)
+	units, lang, err := weatherOptions(req.Units, req.Lang)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "weather fetch error: %v", err)
+		return nil, err
+	}
+
+	data, err := s.service.GetByCity(ctx, req.City, lang)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "weather fetch error: %v", err)
+	}
+	return toWeatherResponse(models.ConvertWeather(data, units)), nil
+}
+
+// GetByCoordinates returns the current weather at a point, named after the nearest city.
+func (s *WeatherGRPCServer) GetByCoordinates(
+	ctx context.Context,
+	req *weatherpb.CoordinatesRequest,
+) (*weatherpb.WeatherResponse, error) {
+	if math.IsNaN(req.Lat) || req.Lat < -90 || req.Lat > 90 {
+		return nil, status.Error(codes.InvalidArgument, "lat must be between -90 and 90")
+	}
+	if math.IsNaN(req.Lon) || req.Lon < -180 || req.Lon > 180 {
+		return nil, status.Error(codes.InvalidArgument, "lon must be between -180 and 180")
+	}
+
+	data, err := s.service.GetByCoordinates(ctx, req.Lat, req.Lon)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "weather fetch error: %v", err)
+	}
+	return toWeatherResponse(data), nil
+}
+
+func (s *WeatherGRPCServer) GetByCities(
+	ctx context.Context,
+	req *weatherpb.CitiesRequest,
+) (*weatherpb.CitiesResponse, error) {
+	if len(req.Cities) == 0 || len(req.Cities) > models.MaxBatchCities {
+		return nil, status.Errorf(codes.InvalidArgument, "between 1 and %d cities are required", models.MaxBatchCities)
+	}
+
+	units, lang, err := weatherOptions(req.Units, req.Lang)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := s.service.GetByCities(ctx, req.Cities, lang)
+
+	resp := &weatherpb.CitiesResponse{
+		Weather: make(map[string]*weatherpb.WeatherResponse, len(batch.Weather)),
+		Errors:  make(map[string]string, len(batch.Errors)),
+	}
+	for city, data := range batch.Weather {
+		resp.Weather[city] = toWeatherResponse(models.ConvertWeather(data, units))
+	}
+	for city, err := range batch.Errors {
+		resp.Errors[city] = err.Error()
+	}
+	return resp, nil
+}
+
+func (s *WeatherGRPCServer) GetForecast(
+	ctx context.Context,
+	req *weatherpb.ForecastRequest,
+) (*weatherpb.ForecastResponse, error) {
+	if req.City == "" {
+		return nil, status.Error(codes.InvalidArgument, "city is required")
+	}
+
+	days := int(req.Days)
+	if days == 0 {
+		days = models.DefaultForecastDays
+	}
+	if days < 1 || days > models.MaxForecastDays {
+		return nil, status.Errorf(codes.InvalidArgument, "days must be between 1 and %d", models.MaxForecastDays)
+	}
+
+	forecast, err := s.service.GetForecast(ctx, req.City, days)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "forecast fetch error: %v", err)
+	}
+
+	resp := &weatherpb.ForecastResponse{City: forecast.City, Stale: forecast.Stale}
+	for _, d := range forecast.Days {
+		resp.Days = append(resp.Days, &weatherpb.DailyForecast{
+			Date:                d.Date,
+			MinTemperature:      d.MinTemperature,
+			MaxTemperature:      d.MaxTemperature,
+			Condition:           d.Condition,
+			ConditionCode:       toConditionPB(d.ConditionCode),
+			PrecipitationChance: d.PrecipitationChance,
+			PrecipitationMm:     d.PrecipitationMM,
+		})
+	}
+	return resp, nil
+}
+
+// SearchCities autocompletes city names from the built-in catalog.
+func (s *WeatherGRPCServer) SearchCities(
+	_ context.Context,
+	req *weatherpb.SearchCitiesRequest,
+) (*weatherpb.SearchCitiesResponse, error) {
+	if strings.TrimSpace(req.Prefix) == "" {
+		return nil, status.Error(codes.InvalidArgument, "prefix is required")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = models.DefaultSearchLimit
+	}
+	if limit < 1 || limit > models.MaxSearchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", models.MaxSearchLimit)
+	}
+
+	resp := &weatherpb.SearchCitiesResponse{}
+	for _, c := range s.cities.Search(req.Prefix, limit) {
+		resp.Cities = append(resp.Cities, &weatherpb.City{
+			Id:         c.ID,
+			Name:       c.Name,
+			Country:    c.Country,
+			Latitude:   c.Latitude,
+			Longitude:  c.Longitude,
+			Timezone:   c.Timezone,
+			Population: int64(c.Population),
+		})
+	}
+	return resp, nil
+}
+
+// WatchCity sends the current weather for a city, then every refreshed value that
+// clears the requested temperature threshold, until the client goes away.
+func (s *WeatherGRPCServer) WatchCity(
+	req *weatherpb.WatchRequest,
+	stream weatherpb.WeatherService_WatchCityServer,
+) error {
+	if req.City == "" {
+		return status.Error(codes.InvalidArgument, "city is required")
+	}
+	if req.TemperatureThreshold < 0 {
+		return status.Error(codes.InvalidArgument, "temperature_threshold must not be negative")
+	}
+	ctx := stream.Context()
+
+	// Subscribe first so a refresh racing the initial fetch is not lost.
+	updates, unsubscribe := s.watcher.Subscribe(s.cities.Resolve(req.City).ID)
+	defer unsubscribe()
+
+	last, err := s.service.GetByCity(ctx, req.City, "")
+	if err != nil {
+		return status.Errorf(errorCode(err), "weather fetch error: %v", err)
+	}
+	if err := stream.Send(toWeatherResponse(last)); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			// A cache hit is a no-op; an expired entry is refetched and published to updates.
+			_, _ = s.service.GetByCity(ctx, req.City, "")
+		case data := <-updates:
+			if !changedPast(last, data, req.TemperatureThreshold) {
+				continue
+			}
+			if err := stream.Send(toWeatherResponse(data)); err != nil {
+				return err
+			}
+			last = data
+		}
+	}
+}
+
+// changedPast reports whether next moved far enough from the last value sent to be
+// worth pushing; a zero threshold pushes every refresh.
+func changedPast(last, next models.WeatherData, threshold float64) bool {
+	if threshold == 0 {
+		return true
+	}
+	return math.Abs(next.Temperature-last.Temperature) >= threshold ||
+		next.Condition != last.Condition || next.ConditionCode != last.ConditionCode
+}
+
+// weatherOptions validates the units and language of a request, defaulting to
+// metric and English, and normalizes the language code.
+func weatherOptions(units, lang string) (string, string, error) {
+	if units == "" {
+		units = models.DefaultUnits
+	}
+	if !models.ValidUnits(units) {
+		return "", "", status.Error(codes.InvalidArgument, "units must be one of metric, imperial and standard")
+	}
+	lang, ok := models.NormalizeLang(lang)
+	if !ok {
+		return "", "", status.Error(codes.InvalidArgument, "lang must be a language code such as uk or pt_br")
+	}
+	return units, lang, nil
+}
+
+func toWeatherResponse(data models.WeatherData) *weatherpb.WeatherResponse {
+	resp := &weatherpb.WeatherResponse{
+		City:          data.City,
+		Temperature:   data.Temperature,
+		Condition:     data.Condition,
+		ConditionCode: toConditionPB(data.ConditionCode),
+		Humidity:      int32(data.Humidity),
+		WindSpeed:     data.WindSpeed,
+		WindDirection: int32(data.WindDirection),
+		Pressure:      data.Pressure,
+		FeelsLike:     data.FeelsLike,
+		Provider:      data.Provider,
+		Units:         data.Units,
+
+		Contributors:      data.Contributors,
+		TemperatureSpread: data.TemperatureSpread,
+		Stale:             data.Stale,
+	}
+	if !data.ObservedAt.IsZero() {
+		resp.ObservedAt = timestamppb.New(data.ObservedAt)
+	}
+	return resp
+}
+
+var conditionsPB = map[models.Condition]weatherpb.Condition{
+	models.ConditionClear:        weatherpb.Condition_CONDITION_CLEAR,
+	models.ConditionPartlyCloudy: weatherpb.Condition_CONDITION_PARTLY_CLOUDY,
+	models.ConditionCloudy:       weatherpb.Condition_CONDITION_CLOUDY,
+	models.ConditionFog:          weatherpb.Condition_CONDITION_FOG,
+	models.ConditionDrizzle:      weatherpb.Condition_CONDITION_DRIZZLE,
+	models.ConditionRain:         weatherpb.Condition_CONDITION_RAIN,
+	models.ConditionSleet:        weatherpb.Condition_CONDITION_SLEET,
+	models.ConditionSnow:         weatherpb.Condition_CONDITION_SNOW,
+	models.ConditionThunderstorm: weatherpb.Condition_CONDITION_THUNDERSTORM,
+}
+
+// toConditionPB maps a canonical condition onto the proto enum; unknown and
+// unset conditions are unspecified.
+func toConditionPB(c models.Condition) weatherpb.Condition {
+	return conditionsPB[c]
+}
+
+// errorCode maps provider error kinds onto gRPC codes; grpc-gateway turns these
+// into 404, 429 and 503 respectively.
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, models.ErrCityNotFound):
+		return codes.NotFound
+	case errors.Is(err, models.ErrQuotaExceeded):
+		return codes.ResourceExhausted
+	case errors.Is(err, models.ErrProviderUnavailable):
+		return codes.Unavailable
+	default:
+		return codes.Internal
 	}
-	return &weatherpb.WeatherResponse{
-		City:        data.City,
-		Temperature: data.Temperature,
-		Condition:   data.Condition,
-	}, nil
 }
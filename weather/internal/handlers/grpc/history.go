@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// defaultHistorySpan is how far back GetHistory looks when from is omitted.
+const defaultHistorySpan = 24 * time.Hour
+
+type historyReader interface {
+	History(ctx context.Context, city string, from, to time.Time, granularity string) (models.History, error)
+}
+
+// GetHistory returns recorded observations of a city, downsampled to the
+// requested granularity.
+func (s *WeatherGRPCServer) GetHistory(
+	ctx context.Context,
+	req *weatherpb.HistoryRequest,
+) (*weatherpb.HistoryResponse, error) {
+	if strings.TrimSpace(req.City) == "" {
+		return nil, status.Error(codes.InvalidArgument, "city is required")
+	}
+
+	granularity := req.Granularity
+	if granularity == "" {
+		granularity = models.DefaultGranularity
+	}
+	if _, ok := models.GranularityBucket(granularity); !ok {
+		return nil, status.Error(codes.InvalidArgument, "granularity must be one of raw, hour and day")
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	from := to.Add(-defaultHistorySpan)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+	maxSpan := models.MaxHistorySpan
+	if granularity == models.GranularityRaw {
+		maxSpan = models.MaxRawHistorySpan
+	}
+	if to.Sub(from) > maxSpan {
+		return nil, status.Errorf(codes.InvalidArgument, "%s history spans at most %d days",
+			granularity, int(maxSpan.Hours()/24))
+	}
+
+	history, err := s.history.History(ctx, req.City, from, to, granularity)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "history read error: %v", err)
+	}
+
+	resp := &weatherpb.HistoryResponse{City: history.City, Granularity: history.Granularity}
+	for _, p := range history.Points {
+		resp.Points = append(resp.Points, &weatherpb.HistoryPoint{
+			Time:           timestamppb.New(p.Time),
+			Temperature:    p.Temperature,
+			MinTemperature: p.MinTemperature,
+			MaxTemperature: p.MaxTemperature,
+			FeelsLike:      p.FeelsLike,
+			Humidity:       p.Humidity,
+			WindSpeed:      p.WindSpeed,
+			Pressure:       p.Pressure,
+			Samples:        int32(p.Samples),
+			Condition:      p.Condition,
+			Provider:       p.Provider,
+		})
+	}
+	return resp, nil
+}
@@ -2,8 +2,11 @@ package http
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
-	"strings"
+	"strconv"
 	"time"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
@@ -14,7 +17,9 @@ import (
 const timeoutDuration = 10 * time.Second
 
 type weatherGetterService interface {
-	GetByCity(ctx context.Context, city string) (models.WeatherData, error)
+	GetByCity(ctx context.Context, city, lang string) (models.WeatherData, error)
+	GetByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error)
+	GetForecast(ctx context.Context, city string, days int) (models.Forecast, error)
 }
 
 type Handler struct {
@@ -31,18 +36,93 @@ func (h *Handler) GetWeather(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "city query parameter is required"})
 		return
 	}
+	units := c.DefaultQuery("units", models.DefaultUnits)
+	if !models.ValidUnits(units) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "units must be one of metric, imperial and standard"})
+		return
+	}
+	lang, ok := models.NormalizeLang(c.Query("lang"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lang must be a language code such as uk or pt_br"})
+		return
+	}
 	ctxWithTimeout, cancel := context.WithTimeout(c.Request.Context(), timeoutDuration)
 	defer cancel()
 
-	data, err := h.service.GetByCity(ctxWithTimeout, city)
+	data, err := h.service.GetByCity(ctxWithTimeout, city, lang)
 	if err != nil {
-		if strings.Contains(err.Error(), "status 404") {
-			c.JSON(http.StatusNotFound, gin.H{"error": "City not found"})
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ConvertWeather(data, units))
+}
+
+func (h *Handler) GetWeatherByCoordinates(c *gin.Context) {
+	lat, err := strconv.ParseFloat(c.Query("lat"), 64)
+	if err != nil || math.IsNaN(lat) || lat < -90 || lat > 90 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lat must be between -90 and 90"})
+		return
+	}
+	lon, err := strconv.ParseFloat(c.Query("lon"), 64)
+	if err != nil || math.IsNaN(lon) || lon < -180 || lon > 180 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lon must be between -180 and 180"})
+		return
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(c.Request.Context(), timeoutDuration)
+	defer cancel()
+
+	data, err := h.service.GetByCoordinates(ctxWithTimeout, lat, lon)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+func (h *Handler) GetForecast(c *gin.Context) {
+	city := c.Query("city")
+	if city == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "city query parameter is required"})
+		return
+	}
+
+	days := models.DefaultForecastDays
+	if raw := c.Query("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > models.MaxForecastDays {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("days must be between 1 and %d", models.MaxForecastDays),
+			})
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		days = parsed
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(c.Request.Context(), timeoutDuration)
+	defer cancel()
+
+	forecast, err := h.service.GetForecast(ctxWithTimeout, city, days)
+	if err != nil {
+		writeError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, data)
+	c.JSON(http.StatusOK, forecast)
+}
+
+// writeError responds with the HTTP status matching the provider error kind.
+func writeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrCityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "City not found"})
+	case errors.Is(err, models.ErrQuotaExceeded):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+	case errors.Is(err, models.ErrProviderUnavailable):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
 }
@@ -4,11 +4,13 @@ package http_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	http2 "github.com/Nazarious-ucu/weather-subscription-api/weather/internal/handlers/http"
 
@@ -25,8 +27,8 @@ type mockService struct {
 	mock.Mock
 }
 
-func (m *mockService) GetByCity(ctx context.Context, city string) (models.WeatherData, error) {
-	args := m.Called(ctx, city)
+func (m *mockService) GetByCity(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	args := m.Called(ctx, city, lang)
 
 	data, ok := args.Get(0).(models.WeatherData)
 
@@ -37,6 +39,30 @@ func (m *mockService) GetByCity(ctx context.Context, city string) (models.Weathe
 	return data, args.Error(1)
 }
 
+func (m *mockService) GetByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	args := m.Called(ctx, lat, lon)
+
+	data, ok := args.Get(0).(models.WeatherData)
+
+	if !ok {
+		return models.WeatherData{}, args.Error(1)
+	}
+
+	return data, args.Error(1)
+}
+
+func (m *mockService) GetForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	args := m.Called(ctx, city, days)
+
+	data, ok := args.Get(0).(models.Forecast)
+
+	if !ok {
+		return models.Forecast{}, args.Error(1)
+	}
+
+	return data, args.Error(1)
+}
+
 func TestGetWeather_NoCity(t *testing.T) {
 	rec := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(rec)
@@ -66,7 +92,7 @@ func TestGetWeather_ServiceError(t *testing.T) {
 
 	m := &mockService{}
 
-	m.On("GetByCity", mock.Anything, mock.Anything).
+	m.On("GetByCity", mock.Anything, mock.Anything, "").
 		Return(models.WeatherData{}, errors.New("service unavailable")).Once()
 
 	t.Cleanup(func() {
@@ -86,13 +112,57 @@ func TestGetWeather_ServiceError(t *testing.T) {
 	assert.JSONEq(t, `{"error":"service unavailable"}`, rec.Body.String())
 }
 
+func TestGetWeather_ProviderErrorKinds(t *testing.T) {
+	tests := []struct {
+		name string
+		kind error
+		code int
+	}{
+		{name: "CityNotFound", kind: models.ErrCityNotFound, code: http.StatusNotFound},
+		{name: "QuotaExceeded", kind: models.ErrQuotaExceeded, code: http.StatusTooManyRequests},
+		{name: "ProviderUnavailable", kind: models.ErrProviderUnavailable, code: http.StatusServiceUnavailable},
+		{name: "InvalidResponse", kind: models.ErrInvalidResponse, code: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+
+			serviceErr := fmt.Errorf("all weather API clients failed: %w",
+				models.NewProviderError("WeatherAPI", tt.kind, errors.New("status")))
+
+			m := &mockService{}
+			m.On("GetByCity", mock.Anything, "Atlantis", "").
+				Return(models.WeatherData{}, serviceErr).Once()
+
+			t.Cleanup(func() {
+				m.AssertExpectations(t)
+			})
+
+			req, err := http.NewRequest(http.MethodGet, "/weather?city=Atlantis", nil)
+			require.NoError(t, err)
+
+			c.Request = req
+
+			http2.NewHandler(m).GetWeather(c)
+
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
 func TestGetWeather_Success(t *testing.T) {
 	rec := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(rec)
-	data := models.WeatherData{City: "Kyiv", Temperature: 20.5, Condition: "Sunny"}
+	data := models.WeatherData{
+		City: "Kyiv", Temperature: 20.5, Condition: "Sunny",
+		Humidity: 40, WindSpeed: 3.5, WindDirection: 180, Pressure: 1012, FeelsLike: 19.8,
+		ObservedAt: time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC), Provider: "WeatherAPI",
+	}
 
 	m := &mockService{}
-	m.On("GetByCity", mock.Anything, mock.Anything).Return(data, nil).Once()
+	m.On("GetByCity", mock.Anything, "Kyiv", "").Return(data, nil).Once()
 
 	t.Cleanup(func() {
 		m.AssertExpectations(t)
@@ -108,6 +178,160 @@ func TestGetWeather_Success(t *testing.T) {
 	h.GetWeather(c)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
-	assert.JSONEq(t, fmt.Sprintf(`{"city":"%s","temperature":%v,"condition":"%s"}`,
-		data.City, data.Temperature, data.Condition), rec.Body.String())
+	assert.JSONEq(t, fmt.Sprintf(`{"city":"%s","temperature":%v,"condition":"%s","humidity":%d,
+		"wind_speed":%v,"wind_direction":%d,"pressure":%v,"feels_like":%v,
+		"observed_at":"2025-07-01T12:00:00Z","provider":"%s","units":"metric"}`,
+		data.City, data.Temperature, data.Condition, data.Humidity,
+		data.WindSpeed, data.WindDirection, data.Pressure, data.FeelsLike, data.Provider), rec.Body.String())
+}
+
+func TestGetWeather_UnitsAndLang(t *testing.T) {
+	data := models.WeatherData{City: "Kyiv", Temperature: 20, FeelsLike: 10, WindSpeed: 10, Condition: "Сонячно"}
+
+	tests := []struct {
+		name     string
+		query    string
+		lang     string
+		code     int
+		wantTemp float64
+		wantWind float64
+		wantUnit string
+	}{
+		{name: "Imperial", query: "&units=imperial&lang=uk", lang: "uk", code: http.StatusOK,
+			wantTemp: 68, wantWind: 22.369362920544, wantUnit: models.UnitsImperial},
+		{name: "Standard", query: "&units=standard&lang=pt-BR", lang: "pt_br", code: http.StatusOK,
+			wantTemp: 293.15, wantWind: 10, wantUnit: models.UnitsStandard},
+		{name: "UnknownUnits", query: "&units=nautical", code: http.StatusBadRequest},
+		{name: "BadLang", query: "&lang=ukrainian", code: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+
+			m := &mockService{}
+			if tt.code == http.StatusOK {
+				m.On("GetByCity", mock.Anything, "Kyiv", tt.lang).Return(data, nil).Once()
+			}
+			t.Cleanup(func() {
+				m.AssertExpectations(t)
+			})
+
+			req, err := http.NewRequest(http.MethodGet, "/weather?city=Kyiv"+tt.query, nil)
+			require.NoError(t, err)
+			c.Request = req
+
+			http2.NewHandler(m).GetWeather(c)
+
+			require.Equal(t, tt.code, rec.Code)
+			if tt.code != http.StatusOK {
+				return
+			}
+			var got models.WeatherData
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.InDelta(t, tt.wantTemp, got.Temperature, 1e-9)
+			assert.InDelta(t, tt.wantWind, got.WindSpeed, 1e-9)
+			assert.Equal(t, tt.wantUnit, got.Units)
+		})
+	}
+}
+
+func TestGetForecast_DaysOutOfRange(t *testing.T) {
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+
+	m := &mockService{}
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "/weather/forecast?city=Kyiv&days=10", nil)
+	require.NoError(t, err)
+
+	c.Request = req
+
+	h := http2.NewHandler(m)
+
+	h.GetForecast(c)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.JSONEq(t, `{"error":"days must be between 1 and 5"}`, rec.Body.String())
+}
+
+func TestGetForecast_DefaultDays(t *testing.T) {
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	forecast := models.Forecast{
+		City: "Kyiv",
+		Days: []models.DailyForecast{{
+			Date: "2025-07-01", MinTemperature: 14, MaxTemperature: 25,
+			Condition: "Rain", PrecipitationChance: 80, PrecipitationMM: 4.5,
+		}},
+	}
+
+	m := &mockService{}
+	m.On("GetForecast", mock.Anything, "Kyiv", models.DefaultForecastDays).Return(forecast, nil).Once()
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "/weather/forecast?city=Kyiv", nil)
+	require.NoError(t, err)
+
+	c.Request = req
+
+	h := http2.NewHandler(m)
+
+	h.GetForecast(c)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"city":"Kyiv","days":[{"date":"2025-07-01","min_temperature":14,"max_temperature":25,
+		"condition":"Rain","precipitation_chance":80,"precipitation_mm":4.5}]}`, rec.Body.String())
+}
+
+func TestGetWeatherByCoordinates(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		code  int
+		body  string
+	}{
+		{name: "Success", query: "lat=49.84&lon=24.03", code: http.StatusOK},
+		{name: "MissingLat", query: "lon=24.03", code: http.StatusBadRequest,
+			body: `{"error":"lat must be between -90 and 90"}`},
+		{name: "LonOutOfRange", query: "lat=49.84&lon=181", code: http.StatusBadRequest,
+			body: `{"error":"lon must be between -180 and 180"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+
+			m := &mockService{}
+			if tt.code == http.StatusOK {
+				m.On("GetByCoordinates", mock.Anything, 49.84, 24.03).
+					Return(models.WeatherData{City: "Lviv", Temperature: 21}, nil).Once()
+			}
+			t.Cleanup(func() {
+				m.AssertExpectations(t)
+			})
+
+			req, err := http.NewRequest(http.MethodGet, "/weather/coordinates?"+tt.query, nil)
+			require.NoError(t, err)
+			c.Request = req
+
+			http2.NewHandler(m).GetWeatherByCoordinates(c)
+
+			assert.Equal(t, tt.code, rec.Code)
+			if tt.body != "" {
+				assert.JSONEq(t, tt.body, rec.Body.String())
+			} else {
+				assert.Contains(t, rec.Body.String(), `"city":"Lviv"`)
+			}
+		})
+	}
 }
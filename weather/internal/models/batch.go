@@ -0,0 +1,10 @@
+package models
+
+// MaxBatchCities bounds how many cities a single batch lookup may ask for.
+const MaxBatchCities = 100
+
+// BatchWeather is the outcome of a multi-city lookup keyed by the requested city name.
+type BatchWeather struct {
+	Weather map[string]WeatherData
+	Errors  map[string]error
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// CacheEntry is a cached value together with the time it was fetched, so the
+// cache decorator can tell fresh data from stale data.
+type CacheEntry[T any] struct {
+	Value    T         `json:"value"`
+	StoredAt time.Time `json:"stored_at"`
+}
+
+// ErrNotCached is returned by the cache admin API for keys that are not cached.
+var ErrNotCached = errors.New("not cached")
+
+// Cache entry states, see decorators.CacheTTL.
+const (
+	CacheStateFresh   = "fresh"
+	CacheStateStale   = "stale"
+	CacheStateExpired = "expired"
+)
+
+// CachedItem describes a cache entry for the cache admin API.
+type CachedItem struct {
+	Key      string        `json:"key"`
+	Kind     string        `json:"kind"`           // weather or forecast
+	City     string        `json:"city,omitempty"` // canonical city ID; empty for coordinate lookups
+	StoredAt time.Time     `json:"stored_at"`
+	Age      time.Duration `json:"age"`
+	TTL      time.Duration `json:"ttl"` // until the cache drops the entry, zero if it never does
+	State    string        `json:"state"`
+	Value    any           `json:"value,omitempty"` // only when a single entry is inspected
+}
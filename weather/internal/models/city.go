@@ -0,0 +1,19 @@
+package models
+
+const (
+	DefaultSearchLimit = 10
+	MaxSearchLimit     = 50
+)
+
+// City is a canonical city. ID is stable and used in cache keys; Name is what
+// providers are queried with and what responses report.
+type City struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Country    string   `json:"country,omitempty"` // ISO 3166-1 alpha-2
+	Latitude   float64  `json:"latitude,omitempty"`
+	Longitude  float64  `json:"longitude,omitempty"`
+	Timezone   string   `json:"timezone,omitempty"` // IANA name
+	Population int      `json:"population,omitempty"`
+	Aliases    []string `json:"aliases,omitempty"` // other names and spellings, in any script
+}
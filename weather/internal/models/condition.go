@@ -0,0 +1,30 @@
+package models
+
+// Condition is a provider-independent weather condition. Providers describe the
+// sky in their own words, and in the requested language, so WeatherData keeps
+// that text in Condition and the canonical value in ConditionCode for code that
+// branches on the weather.
+type Condition string
+
+const (
+	ConditionUnknown      Condition = "unknown"
+	ConditionClear        Condition = "clear"
+	ConditionPartlyCloudy Condition = "partly_cloudy"
+	ConditionCloudy       Condition = "cloudy"
+	ConditionFog          Condition = "fog"
+	ConditionDrizzle      Condition = "drizzle"
+	ConditionRain         Condition = "rain"
+	ConditionSleet        Condition = "sleet"
+	ConditionSnow         Condition = "snow"
+	ConditionThunderstorm Condition = "thunderstorm"
+)
+
+// Valid reports whether c is one of the canonical conditions.
+func (c Condition) Valid() bool {
+	switch c {
+	case ConditionUnknown, ConditionClear, ConditionPartlyCloudy, ConditionCloudy, ConditionFog,
+		ConditionDrizzle, ConditionRain, ConditionSleet, ConditionSnow, ConditionThunderstorm:
+		return true
+	}
+	return false
+}
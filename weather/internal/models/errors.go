@@ -0,0 +1,34 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Provider error kinds. Every weather client classifies its failures into one of
+// these so that callers can pick a response code with errors.Is.
+var (
+	ErrCityNotFound        = errors.New("city not found")
+	ErrQuotaExceeded       = errors.New("provider quota exceeded")
+	ErrProviderUnavailable = errors.New("provider unavailable")
+	ErrInvalidResponse     = errors.New("invalid provider response")
+)
+
+// ProviderError attributes a classified failure to the provider that produced it.
+type ProviderError struct {
+	Provider string
+	Kind     error
+	Cause    error
+}
+
+func NewProviderError(provider string, kind, cause error) *ProviderError {
+	return &ProviderError{Provider: provider, Kind: kind, Cause: cause}
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s: %v: %v", e.Provider, e.Kind, e.Cause)
+}
+
+func (e *ProviderError) Unwrap() []error {
+	return []error{e.Kind, e.Cause}
+}
@@ -0,0 +1,24 @@
+package models
+
+const (
+	DefaultForecastDays = 3
+	MaxForecastDays     = 5
+)
+
+type DailyForecast struct {
+	Date                string    `json:"date"`
+	MinTemperature      float64   `json:"min_temperature"`
+	MaxTemperature      float64   `json:"max_temperature"`
+	Condition           string    `json:"condition"`
+	ConditionCode       Condition `json:"condition_code,omitempty"`
+	PrecipitationChance float64   `json:"precipitation_chance"`
+	PrecipitationMM     float64   `json:"precipitation_mm"`
+}
+
+type Forecast struct {
+	City string          `json:"city"`
+	Days []DailyForecast `json:"days"`
+
+	// Stale is set when the forecast was served from cache past its soft TTL.
+	Stale bool `json:"stale,omitempty"`
+}
@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+// History granularities: every stored observation, or hourly and daily (UTC)
+// averages.
+const (
+	GranularityRaw  = "raw"
+	GranularityHour = "hour"
+	GranularityDay  = "day"
+
+	DefaultGranularity = GranularityHour
+)
+
+// Limits on the span of a single history query.
+const (
+	MaxHistorySpan    = 366 * 24 * time.Hour
+	MaxRawHistorySpan = 7 * 24 * time.Hour
+)
+
+// GranularityBucket is the length of the buckets observations are averaged over
+// at the given granularity; zero for raw observations. It reports false for an
+// unknown granularity.
+func GranularityBucket(granularity string) (time.Duration, bool) {
+	switch granularity {
+	case GranularityRaw:
+		return 0, true
+	case GranularityHour:
+		return time.Hour, true
+	case GranularityDay:
+		return 24 * time.Hour, true
+	default:
+		return 0, false
+	}
+}
+
+// Observation is the weather a provider reported for a city, as stored for history.
+type Observation struct {
+	CityID    string
+	Weather   WeatherData
+	FetchedAt time.Time
+}
+
+// HistoryPoint is one raw observation or the average of a bucket of them.
+// Condition and Provider are set for raw observations only.
+type HistoryPoint struct {
+	Time           time.Time `json:"time"`
+	Temperature    float64   `json:"temperature"`
+	MinTemperature float64   `json:"min_temperature"`
+	MaxTemperature float64   `json:"max_temperature"`
+	FeelsLike      float64   `json:"feels_like"`
+	Humidity       float64   `json:"humidity"`
+	WindSpeed      float64   `json:"wind_speed"`
+	Pressure       float64   `json:"pressure"`
+	Samples        int       `json:"samples"`
+	Condition      string    `json:"condition,omitempty"`
+	Provider       string    `json:"provider,omitempty"`
+}
+
+type History struct {
+	City        string         `json:"city"`
+	Granularity string         `json:"granularity"`
+	Points      []HistoryPoint `json:"points"`
+}
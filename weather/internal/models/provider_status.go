@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// ProviderStatus is a snapshot of a provider's circuit breaker, recent outcomes and
+// its place in the fallback chain.
+type ProviderStatus struct {
+	Name                 string    `json:"name"`
+	State                string    `json:"state"`
+	Requests             uint32    `json:"requests"`
+	TotalSuccesses       uint32    `json:"total_successes"`
+	TotalFailures        uint32    `json:"total_failures"`
+	ConsecutiveSuccesses uint32    `json:"consecutive_successes"`
+	ConsecutiveFailures  uint32    `json:"consecutive_failures"`
+	LastError            string    `json:"last_error,omitempty"`
+	LastErrorAt          time.Time `json:"last_error_at,omitzero"`
+	LastSuccessAt        time.Time `json:"last_success_at,omitzero"`
+
+	Priority     int     `json:"priority"` // 1 is tried first
+	Weight       float64 `json:"weight"`
+	Score        float64 `json:"score"`
+	Samples      int     `json:"samples"` // calls the success rate is based on
+	SuccessRate  float64 `json:"success_rate"`
+	LatencyP50Ms int64   `json:"latency_p50_ms,omitempty"`
+
+	QuotaExhausted bool `json:"quota_exhausted,omitempty"` // call budget spent, skipped until it resets
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// QuotaWindow is a budget of provider calls per fixed window of time, such as 60
+// calls a minute. Windows are aligned to the Unix epoch, so a day window runs
+// from midnight to midnight UTC.
+type QuotaWindow struct {
+	Name   string // labels the window in metrics and counter keys
+	Period time.Duration
+	Limit  int64
+}
+
+// Start returns the start of the window t falls in.
+func (w QuotaWindow) Start(t time.Time) time.Time {
+	return t.Truncate(w.Period)
+}
+
+// End returns the end of the window t falls in.
+func (w QuotaWindow) End(t time.Time) time.Time {
+	return w.Start(t).Add(w.Period)
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Unit systems weather can be reported in. Providers are always queried and
+// cached in metric; other systems are converted from it on the way out.
+const (
+	UnitsMetric   = "metric"   // °C, m/s
+	UnitsImperial = "imperial" // °F, mph
+	UnitsStandard = "standard" // K, m/s
+
+	DefaultUnits = UnitsMetric
+)
+
+const (
+	kelvinOffset = 273.15
+	mpsToMph     = 2.2369362920544
+)
+
+// ValidUnits reports whether units names a supported unit system.
+func ValidUnits(units string) bool {
+	switch units {
+	case UnitsMetric, UnitsImperial, UnitsStandard:
+		return true
+	default:
+		return false
+	}
+}
+
+// ConvertWeather converts metric weather into units. Pressure stays in hPa in
+// every system.
+func ConvertWeather(data WeatherData, units string) WeatherData {
+	switch units {
+	case UnitsImperial:
+		data.Temperature = celsiusToFahrenheit(data.Temperature)
+		data.FeelsLike = celsiusToFahrenheit(data.FeelsLike)
+		data.TemperatureSpread *= 9.0 / 5.0
+		data.WindSpeed *= mpsToMph
+	case UnitsStandard:
+		data.Temperature += kelvinOffset
+		data.FeelsLike += kelvinOffset
+	default:
+		units = UnitsMetric
+	}
+	data.Units = units
+	return data
+}
+
+func celsiusToFahrenheit(c float64) float64 {
+	return c*9.0/5.0 + 32
+}
+
+// langPattern matches the language codes providers take, such as "uk", "pt_br"
+// or "zh_tw".
+var langPattern = regexp.MustCompile(`^[a-z]{2}(_[a-z]{2})?$`)
+
+// NormalizeLang canonicalizes a language code such as "pt-BR" to "pt_br" and
+// reports whether it is well-formed. English, the providers' default, and the
+// empty string both normalize to "".
+func NormalizeLang(lang string) (string, bool) {
+	lang = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(lang)), "-", "_")
+	if lang == "" || lang == "en" {
+		return "", true
+	}
+	return lang, langPattern.MatchString(lang)
+}
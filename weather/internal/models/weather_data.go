@@ -1,7 +1,28 @@
 package models
 
+import "time"
+
 type WeatherData struct {
-	City        string  `json:"city"`
-	Temperature float64 `json:"temperature"`
-	Condition   string  `json:"condition"`
+	City          string    `json:"city"`
+	Temperature   float64   `json:"temperature"`
+	Condition     string    `json:"condition"`
+	ConditionCode Condition `json:"condition_code,omitempty"`
+	Humidity      int       `json:"humidity"`
+	WindSpeed     float64   `json:"wind_speed"`
+	WindDirection int       `json:"wind_direction"`
+	Pressure      float64   `json:"pressure"`
+	FeelsLike     float64   `json:"feels_like"`
+	ObservedAt    time.Time `json:"observed_at"`
+	Provider      string    `json:"provider"`
+
+	// Set only by the aggregate strategy: the providers that were combined and
+	// the spread between their lowest and highest temperature.
+	Contributors      []string `json:"contributors,omitempty"`
+	TemperatureSpread float64  `json:"temperature_spread,omitempty"`
+
+	// Units is the unit system the data is in; empty means metric, as fetched.
+	Units string `json:"units,omitempty"`
+
+	// Stale is set when the data was served from cache past its soft TTL.
+	Stale bool `json:"stale,omitempty"`
 }
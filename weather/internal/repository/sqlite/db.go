@@ -0,0 +1,35 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+	_ "modernc.org/sqlite" // registers the "sqlite" driver
+)
+
+const dialect = "sqlite"
+
+// Open opens the SQLite database at path, creating it if needed, and applies
+// the migrations in migrationsDir.
+func Open(ctx context.Context, path, migrationsDir string) (*sql.DB, error) {
+	db, err := sql.Open(dialect, "file:"+path+"?cache=shared&mode=rwc&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("ping %s: %w", path, err)
+	}
+
+	if err := goose.SetDialect(dialect); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if err := goose.UpContext(ctx, db, migrationsDir); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("migrate %s: %w", path, err)
+	}
+	return db, nil
+}
@@ -0,0 +1,171 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// ObservationRepository stores provider observations as a time series per city.
+type ObservationRepository struct {
+	DB  *sql.DB
+	log zerolog.Logger
+}
+
+func NewObservationRepository(db *sql.DB, logger zerolog.Logger) *ObservationRepository {
+	logger = logger.With().Str("component", "ObservationRepository").Logger()
+	return &ObservationRepository{DB: db, log: logger}
+}
+
+// Insert stores an observation. An observation a provider already reported for
+// the same city and time is ignored, so refetching unchanged data does not skew
+// averages.
+func (r *ObservationRepository) Insert(ctx context.Context, obs models.Observation) error {
+	w := obs.Weather
+	observedAt := w.ObservedAt
+	if observedAt.IsZero() {
+		observedAt = obs.FetchedAt
+	}
+
+	_, err := r.DB.ExecContext(ctx, `
+		INSERT OR IGNORE INTO observations
+		    (city_id, city, provider, observed_at, fetched_at, temperature, feels_like,
+		     condition, humidity, wind_speed, wind_direction, pressure)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		obs.CityID, w.City, w.Provider, observedAt.Unix(), obs.FetchedAt.Unix(), w.Temperature, w.FeelsLike,
+		w.Condition, w.Humidity, w.WindSpeed, w.WindDirection, w.Pressure,
+	)
+	if err != nil {
+		return fmt.Errorf("insert observation: %w", err)
+	}
+	return nil
+}
+
+// Range returns the observations of a city observed in [from, to), oldest
+// first. With a non-zero bucket they are averaged over buckets of that length,
+// aligned to the Unix epoch.
+func (r *ObservationRepository) Range(
+	ctx context.Context,
+	cityID string,
+	from, to time.Time,
+	bucket time.Duration,
+) ([]models.HistoryPoint, error) {
+	start := time.Now()
+
+	var (
+		points []models.HistoryPoint
+		err    error
+	)
+	if bucket == 0 {
+		points, err = r.raw(ctx, cityID, from, to)
+	} else {
+		points, err = r.downsampled(ctx, cityID, from, to, bucket)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r.log.Debug().
+		Ctx(ctx).
+		Str("city", cityID).
+		Dur("bucket", bucket).
+		Int("points", len(points)).
+		Dur("duration", time.Since(start)).
+		Msg("observations queried")
+	return points, nil
+}
+
+func (r *ObservationRepository) raw(ctx context.Context, cityID string, from, to time.Time) ([]models.HistoryPoint, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT observed_at, temperature, feels_like, humidity, wind_speed, pressure, condition, provider
+		FROM observations
+		WHERE city_id = ? AND observed_at >= ? AND observed_at < ?
+		ORDER BY observed_at, provider`,
+		cityID, from.Unix(), to.Unix(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query observations: %w", err)
+	}
+	defer r.close(rows)
+
+	var points []models.HistoryPoint
+	for rows.Next() {
+		var (
+			p          models.HistoryPoint
+			observedAt int64
+		)
+		if err := rows.Scan(&observedAt, &p.Temperature, &p.FeelsLike, &p.Humidity, &p.WindSpeed, &p.Pressure,
+			&p.Condition, &p.Provider); err != nil {
+			return nil, fmt.Errorf("scan observation: %w", err)
+		}
+		p.Time = time.Unix(observedAt, 0).UTC()
+		p.MinTemperature, p.MaxTemperature = p.Temperature, p.Temperature
+		p.Samples = 1
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read observations: %w", err)
+	}
+	return points, nil
+}
+
+func (r *ObservationRepository) downsampled(
+	ctx context.Context,
+	cityID string,
+	from, to time.Time,
+	bucket time.Duration,
+) ([]models.HistoryPoint, error) {
+	size := int64(bucket.Seconds())
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT (observed_at / ?) * ? AS bucket,
+		       AVG(temperature), MIN(temperature), MAX(temperature),
+		       AVG(feels_like), AVG(humidity), AVG(wind_speed), AVG(pressure), COUNT(*)
+		FROM observations
+		WHERE city_id = ? AND observed_at >= ? AND observed_at < ?
+		GROUP BY bucket
+		ORDER BY bucket`,
+		size, size, cityID, from.Unix(), to.Unix(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query observations: %w", err)
+	}
+	defer r.close(rows)
+
+	var points []models.HistoryPoint
+	for rows.Next() {
+		var (
+			p     models.HistoryPoint
+			start int64
+		)
+		if err := rows.Scan(&start, &p.Temperature, &p.MinTemperature, &p.MaxTemperature,
+			&p.FeelsLike, &p.Humidity, &p.WindSpeed, &p.Pressure, &p.Samples); err != nil {
+			return nil, fmt.Errorf("scan observation bucket: %w", err)
+		}
+		p.Time = time.Unix(start, 0).UTC()
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read observation buckets: %w", err)
+	}
+	return points, nil
+}
+
+// DeleteBefore drops every observation made before t and returns how many it dropped.
+func (r *ObservationRepository) DeleteBefore(ctx context.Context, t time.Time) (int64, error) {
+	res, err := r.DB.ExecContext(ctx, `DELETE FROM observations WHERE observed_at < ?`, t.Unix())
+	if err != nil {
+		return 0, fmt.Errorf("delete observations: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+func (r *ObservationRepository) close(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		r.log.Error().Err(err).Msg("failed to close rows after query")
+	}
+}
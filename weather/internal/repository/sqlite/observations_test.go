@@ -0,0 +1,103 @@
+//go:build unit
+
+package sqlite_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/repository/sqlite"
+)
+
+func TestObservationRepository(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlite.Open(ctx, filepath.Join(t.TempDir(), "history.db"), "../../../migrations")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := sqlite.NewObservationRepository(db, zerolog.Nop())
+
+	day := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	observe := func(cityID, provider string, at time.Time, temp float64) {
+		t.Helper()
+		require.NoError(t, repo.Insert(ctx, models.Observation{
+			CityID: cityID,
+			Weather: models.WeatherData{
+				City:        "Kyiv",
+				Temperature: temp,
+				Humidity:    50,
+				Condition:   "Clear",
+				ObservedAt:  at,
+				Provider:    provider,
+			},
+			FetchedAt: at.Add(time.Minute),
+		}))
+	}
+	observe("kyiv-ua", "WeatherAPI", day.Add(9*time.Hour), 18)
+	observe("kyiv-ua", "WeatherAPI", day.Add(9*time.Hour), 99) // same observation refetched
+	observe("kyiv-ua", "OpenWeather", day.Add(9*time.Hour+15*time.Minute), 20)
+	observe("kyiv-ua", "WeatherAPI", day.Add(10*time.Hour+30*time.Minute), 22)
+	observe("kyiv-ua", "WeatherAPI", day.Add(33*time.Hour), 15)
+	observe("lviv-ua", "WeatherAPI", day.Add(9*time.Hour), 10)
+
+	t.Run("Raw", func(t *testing.T) {
+		points, err := repo.Range(ctx, "kyiv-ua", day, day.Add(24*time.Hour), 0)
+		require.NoError(t, err)
+
+		require.Len(t, points, 3)
+		assert.Equal(t, models.HistoryPoint{
+			Time:           day.Add(9 * time.Hour),
+			Temperature:    18,
+			MinTemperature: 18,
+			MaxTemperature: 18,
+			Humidity:       50,
+			Samples:        1,
+			Condition:      "Clear",
+			Provider:       "WeatherAPI",
+		}, points[0])
+		assert.Equal(t, "OpenWeather", points[1].Provider)
+	})
+
+	t.Run("Hourly", func(t *testing.T) {
+		points, err := repo.Range(ctx, "kyiv-ua", day, day.Add(24*time.Hour), time.Hour)
+		require.NoError(t, err)
+
+		require.Len(t, points, 2)
+		assert.Equal(t, day.Add(9*time.Hour), points[0].Time)
+		assert.Equal(t, 19.0, points[0].Temperature)
+		assert.Equal(t, 18.0, points[0].MinTemperature)
+		assert.Equal(t, 20.0, points[0].MaxTemperature)
+		assert.Equal(t, 2, points[0].Samples)
+		assert.Empty(t, points[0].Provider)
+		assert.Equal(t, day.Add(10*time.Hour), points[1].Time)
+	})
+
+	t.Run("Daily", func(t *testing.T) {
+		points, err := repo.Range(ctx, "kyiv-ua", day, day.Add(48*time.Hour), 24*time.Hour)
+		require.NoError(t, err)
+
+		require.Len(t, points, 2)
+		assert.Equal(t, day, points[0].Time)
+		assert.Equal(t, 20.0, points[0].Temperature)
+		assert.Equal(t, 3, points[0].Samples)
+		assert.Equal(t, day.Add(24*time.Hour), points[1].Time)
+		assert.Equal(t, 15.0, points[1].Temperature)
+	})
+
+	t.Run("DeleteBefore", func(t *testing.T) {
+		deleted, err := repo.DeleteBefore(ctx, day.Add(10*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+
+		points, err := repo.Range(ctx, "kyiv-ua", day, day.Add(48*time.Hour), 0)
+		require.NoError(t, err)
+		assert.Len(t, points, 2)
+	})
+}
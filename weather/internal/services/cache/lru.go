@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"path"
+	"sync"
+	"time"
+)
+
+// ErrMiss is returned by Get when a key is not cached.
+var ErrMiss = errors.New("cache miss")
+
+type lruItem[T any] struct {
+	key       string
+	value     T
+	expiresAt time.Time
+}
+
+// LRU is an in-process cache holding at most size entries, each for ttl.
+type LRU[T any] struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	order *list.List // front is the most recently used
+	items map[string]*list.Element
+}
+
+func NewLRU[T any](size int, ttl time.Duration) *LRU[T] {
+	return &LRU[T]{
+		size:  size,
+		ttl:   ttl,
+		order: list.New(),
+		items: make(map[string]*list.Element, size),
+	}
+}
+
+func (c *LRU[T]) Set(_ context.Context, key string, value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item := &lruItem[T]{key: key, value: value, expiresAt: time.Now().Add(c.ttl)}
+	if el, ok := c.items[key]; ok {
+		el.Value = item
+		c.order.MoveToFront(el)
+		return nil
+	}
+
+	c.items[key] = c.order.PushFront(item)
+	if c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+//nolint:ireturn
+func (c *LRU[T]) Get(_ context.Context, key string) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero T
+	el, ok := c.items[key]
+	if !ok {
+		return zero, ErrMiss
+	}
+	item := el.Value.(*lruItem[T])
+	if time.Now().After(item.expiresAt) {
+		c.remove(el)
+		return zero, ErrMiss
+	}
+	c.order.MoveToFront(el)
+	return item.value, nil
+}
+
+// Keys returns the live keys matching a glob pattern. path.Match is close enough
+// to Redis globs for cache keys, which contain no slashes.
+func (c *LRU[T]) Keys(_ context.Context, pattern string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	var keys []string
+	for key, el := range c.items {
+		if now.After(el.Value.(*lruItem[T]).expiresAt) {
+			continue
+		}
+		ok, err := path.Match(pattern, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// TTL returns how long key stays cached.
+func (c *LRU[T]) TTL(_ context.Context, key string) (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return 0, ErrMiss
+	}
+	ttl := time.Until(el.Value.(*lruItem[T]).expiresAt)
+	if ttl <= 0 {
+		c.remove(el)
+		return 0, ErrMiss
+	}
+	return ttl, nil
+}
+
+func (c *LRU[T]) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if el, ok := c.items[key]; ok {
+			c.remove(el)
+		}
+	}
+	return nil
+}
+
+func (c *LRU[T]) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*lruItem[T]).key)
+}
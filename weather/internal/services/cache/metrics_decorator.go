@@ -8,6 +8,9 @@ import (
 type cache[T any] interface {
 	Set(ctx context.Context, key string, value T) error
 	Get(ctx context.Context, key string) (T, error)
+	Keys(ctx context.Context, pattern string) ([]string, error)
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	Delete(ctx context.Context, keys ...string) error
 }
 
 type metricsCollector interface {
@@ -57,3 +60,28 @@ func (m *MetricsDecorator[T]) Get(
 	}
 	return data, err
 }
+
+func (m *MetricsDecorator[T]) Keys(ctx context.Context, pattern string) ([]string, error) {
+	start := time.Now()
+	keys, err := m.next.Keys(ctx, pattern)
+	m.collector.ObserveLatency("cache_keys", time.Since(start))
+	return keys, err
+}
+
+func (m *MetricsDecorator[T]) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return m.next.TTL(ctx, key)
+}
+
+func (m *MetricsDecorator[T]) Delete(ctx context.Context, keys ...string) error {
+	start := time.Now()
+	err := m.next.Delete(ctx, keys...)
+	m.collector.ObserveLatency("cache_delete", time.Since(start))
+	counter := "cache_delete_success"
+	if err != nil {
+		counter = "cache_delete_errors"
+	}
+	for _, key := range keys {
+		m.collector.IncrementCounter(counter, key)
+	}
+	return err
+}
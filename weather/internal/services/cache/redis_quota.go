@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+const quotaPrefix = "quota:"
+
+// reserveScript counts one call in every window unless one of them is already
+// at its limit, so a call rejected by one window is not charged to the others.
+// KEYS are the window counters; ARGV holds each window's limit and TTL in
+// seconds, in pairs. It returns 1 or 0 for whether the call was counted,
+// followed by the calls left in each window.
+var reserveScript = redis.NewScript(`
+local allowed = 1
+local used = {}
+for i, key in ipairs(KEYS) do
+	used[i] = tonumber(redis.call('GET', key) or '0')
+	if used[i] >= tonumber(ARGV[2 * i - 1]) then
+		allowed = 0
+	end
+end
+local result = {allowed}
+for i, key in ipairs(KEYS) do
+	if allowed == 1 then
+		used[i] = redis.call('INCR', key)
+		if used[i] == 1 then
+			redis.call('EXPIRE', key, ARGV[2 * i])
+		end
+	end
+	result[i + 1] = math.max(tonumber(ARGV[2 * i - 1]) - used[i], 0)
+end
+return result
+`)
+
+// RedisQuota keeps provider call counters in Redis so that every replica draws
+// from the same budget.
+type RedisQuota struct {
+	client *redis.Client
+}
+
+func NewRedisQuota(client *redis.Client) *RedisQuota {
+	return &RedisQuota{client: client}
+}
+
+// Reserve counts one call by scope at now against every window, unless one of
+// them is spent, and returns the calls left in each window.
+func (q *RedisQuota) Reserve(
+	ctx context.Context,
+	scope string,
+	windows []models.QuotaWindow,
+	now time.Time,
+) ([]int64, bool, error) {
+	keys := make([]string, len(windows))
+	args := make([]any, 0, 2*len(windows))
+	for i, w := range windows {
+		keys[i] = quotaKey(scope, w, now)
+		args = append(args, w.Limit, int64(w.Period/time.Second))
+	}
+
+	res, err := reserveScript.Run(ctx, q.client, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, false, fmt.Errorf("reserve %s quota: %w", scope, err)
+	}
+	if len(res) != len(windows)+1 {
+		return nil, false, fmt.Errorf("reserve %s quota: unexpected reply %v", scope, res)
+	}
+	return res[1:], res[0] == 1, nil
+}
+
+// quotaKey names the counter of a window, such as "quota:WeatherBit:day:1751328000".
+func quotaKey(scope string, w models.QuotaWindow, now time.Time) string {
+	return quotaPrefix + scope + ":" + w.Name + ":" + strconv.FormatInt(w.Start(now).Unix(), 10)
+}
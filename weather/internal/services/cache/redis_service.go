@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -61,6 +62,13 @@ func (c *RedisClient[T]) Get(ctx context.Context, key string) (T, error) {
 	var zero T
 
 	data, err := c.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		c.logger.Debug().
+			Ctx(ctx).
+			Str("key", key).
+			Msg("cache key not found")
+		return zero, ErrMiss
+	}
 	if err != nil {
 		c.logger.Error().
 			Ctx(ctx).
@@ -77,7 +85,8 @@ func (c *RedisClient[T]) Get(ctx context.Context, key string) (T, error) {
 			Str("key", key).
 			Err(err).
 			Msg("failed to unmarshal cached data")
-		return zero, fmt.Errorf("unmarshal: %w", err)
+		// A value that cannot be decoded is treated like a missing one and gets overwritten.
+		return zero, fmt.Errorf("%w: unmarshal: %w", ErrMiss, err)
 	}
 
 	c.logger.Info().
@@ -86,3 +95,62 @@ func (c *RedisClient[T]) Get(ctx context.Context, key string) (T, error) {
 		Msg("cache hit")
 	return *result, nil
 }
+
+// scanCount is the page size hint for SCAN.
+const scanCount = 100
+
+// Keys returns the keys matching a Redis glob pattern. It uses SCAN, so it does
+// not block Redis, but keys written meanwhile may be missed.
+func (c *RedisClient[T]) Keys(ctx context.Context, pattern string) ([]string, error) {
+	var keys []string
+	iter := c.client.Scan(ctx, 0, pattern, scanCount).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		c.logger.Error().
+			Ctx(ctx).
+			Str("pattern", pattern).
+			Err(err).
+			Msg("cache scan failed")
+		return nil, err
+	}
+	return keys, nil
+}
+
+// TTL returns how long key stays cached, or zero if it never expires.
+func (c *RedisClient[T]) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := c.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	// go-redis passes Redis' -2 (no such key) and -1 (no expiry) through unscaled.
+	switch {
+	case ttl == -2:
+		return 0, ErrMiss
+	case ttl < 0:
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (c *RedisClient[T]) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	c.logger.Info().
+		Ctx(ctx).
+		Strs("keys", keys).
+		Msg("deleting from cache")
+
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		c.logger.Error().
+			Ctx(ctx).
+			Strs("keys", keys).
+			Err(err).
+			Msg("cache delete failed")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// remoteRetryInterval is how long the remote tier is bypassed after it fails.
+const remoteRetryInterval = 30 * time.Second
+
+// errRemoteDown is returned by writes that must reach the remote tier while it is bypassed.
+var errRemoteDown = errors.New("remote cache unavailable")
+
+// Tiered reads through a local cache in front of a remote one and writes to
+// both. When the remote tier fails with anything but a miss it is bypassed for
+// remoteRetryInterval, so an unreachable Redis costs one warning instead of an
+// error per request while the local tier keeps serving.
+type Tiered[T any] struct {
+	local  cache[T]
+	remote cache[T]
+	logger zerolog.Logger
+
+	mu        sync.Mutex
+	downUntil time.Time
+}
+
+func NewTiered[T any](local, remote cache[T], logger zerolog.Logger) *Tiered[T] {
+	return &Tiered[T]{local: local, remote: remote, logger: logger}
+}
+
+func (c *Tiered[T]) Set(ctx context.Context, key string, value T) error {
+	_ = c.local.Set(ctx, key, value)
+
+	// A failed remote write is logged by observe; the value is still cached locally.
+	if c.remoteUp() {
+		c.observe(ctx, c.remote.Set(ctx, key, value))
+	}
+	return nil
+}
+
+//nolint:ireturn
+func (c *Tiered[T]) Get(ctx context.Context, key string) (T, error) {
+	if value, err := c.local.Get(ctx, key); err == nil {
+		return value, nil
+	}
+
+	var zero T
+	if !c.remoteUp() {
+		return zero, ErrMiss
+	}
+	value, err := c.remote.Get(ctx, key)
+	c.observe(ctx, err)
+	if err != nil {
+		return zero, ErrMiss
+	}
+
+	_ = c.local.Set(ctx, key, value)
+	return value, nil
+}
+
+// Keys lists the remote tier, which every replica shares, falling back to the
+// local tier while the remote one is down.
+func (c *Tiered[T]) Keys(ctx context.Context, pattern string) ([]string, error) {
+	if c.remoteUp() {
+		keys, err := c.remote.Keys(ctx, pattern)
+		c.observe(ctx, err)
+		if err == nil {
+			return keys, nil
+		}
+	}
+	return c.local.Keys(ctx, pattern)
+}
+
+// TTL reports the remote expiry, which outlives the local one, falling back to
+// the local tier while the remote one is down.
+func (c *Tiered[T]) TTL(ctx context.Context, key string) (time.Duration, error) {
+	if c.remoteUp() {
+		ttl, err := c.remote.TTL(ctx, key)
+		c.observe(ctx, err)
+		if err == nil || errors.Is(err, ErrMiss) {
+			return ttl, err
+		}
+	}
+	return c.local.TTL(ctx, key)
+}
+
+// Delete removes keys from both tiers. Other replicas keep their local copies
+// until the local TTL runs out.
+func (c *Tiered[T]) Delete(ctx context.Context, keys ...string) error {
+	_ = c.local.Delete(ctx, keys...)
+
+	if !c.remoteUp() {
+		return errRemoteDown
+	}
+	err := c.remote.Delete(ctx, keys...)
+	c.observe(ctx, err)
+	return err
+}
+
+func (c *Tiered[T]) remoteUp() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return time.Now().After(c.downUntil)
+}
+
+// observe marks the remote tier down on failure and up again on the first call
+// that gets through, logging only the transitions.
+func (c *Tiered[T]) observe(ctx context.Context, err error) {
+	healthy := err == nil || errors.Is(err, ErrMiss)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wasDown := !c.downUntil.IsZero()
+	switch {
+	case healthy && wasDown:
+		c.downUntil = time.Time{}
+		c.logger.Info().
+			Ctx(ctx).
+			Msg("remote cache reachable again")
+	case !healthy:
+		c.downUntil = time.Now().Add(remoteRetryInterval)
+		if !wasDown {
+			c.logger.Warn().
+				Ctx(ctx).
+				Err(err).
+				Dur("retry_in", remoteRetryInterval).
+				Msg("remote cache unreachable, serving from local cache only")
+		}
+	}
+}
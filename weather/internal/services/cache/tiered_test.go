@@ -0,0 +1,148 @@
+//go:build unit
+
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/cache"
+)
+
+// flakyCache fails every call while down and counts the calls that reach it.
+type flakyCache struct {
+	*cache.LRU[string]
+	down  bool
+	calls int
+}
+
+func (c *flakyCache) Set(ctx context.Context, key string, value string) error {
+	c.calls++
+	if c.down {
+		return errors.New("connection refused")
+	}
+	return c.LRU.Set(ctx, key, value)
+}
+
+func (c *flakyCache) Get(ctx context.Context, key string) (string, error) {
+	c.calls++
+	if c.down {
+		return "", errors.New("connection refused")
+	}
+	return c.LRU.Get(ctx, key)
+}
+
+func (c *flakyCache) Keys(ctx context.Context, pattern string) ([]string, error) {
+	c.calls++
+	if c.down {
+		return nil, errors.New("connection refused")
+	}
+	return c.LRU.Keys(ctx, pattern)
+}
+
+func (c *flakyCache) Delete(ctx context.Context, keys ...string) error {
+	c.calls++
+	if c.down {
+		return errors.New("connection refused")
+	}
+	return c.LRU.Delete(ctx, keys...)
+}
+
+func TestLRU_EvictsLeastRecentlyUsedAndExpired(t *testing.T) {
+	ctx := context.Background()
+	lru := cache.NewLRU[string](2, 50*time.Millisecond)
+
+	require.NoError(t, lru.Set(ctx, "a", "A"))
+	require.NoError(t, lru.Set(ctx, "b", "B"))
+	_, err := lru.Get(ctx, "a")
+	require.NoError(t, err)
+	require.NoError(t, lru.Set(ctx, "c", "C"))
+
+	_, err = lru.Get(ctx, "b")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+	got, err := lru.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "A", got)
+
+	time.Sleep(60 * time.Millisecond)
+	_, err = lru.Get(ctx, "c")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+}
+
+func TestTiered_DegradesToLocalWhenRemoteIsDown(t *testing.T) {
+	ctx := context.Background()
+	l, err := logger.NewLogger("", "tiered_cache_test")
+	require.NoError(t, err)
+
+	remote := &flakyCache{LRU: cache.NewLRU[string](10, time.Hour)}
+	require.NoError(t, remote.LRU.Set(ctx, "remote-only", "R"))
+	tiered := cache.NewTiered[string](cache.NewLRU[string](10, time.Hour), remote, l)
+
+	// A remote hit is copied into the local tier.
+	got, err := tiered.Get(ctx, "remote-only")
+	require.NoError(t, err)
+	assert.Equal(t, "R", got)
+	remote.down = true
+	got, err = tiered.Get(ctx, "remote-only")
+	require.NoError(t, err)
+	assert.Equal(t, "R", got)
+
+	// The first remote failure takes the remote tier out of the loop.
+	_, err = tiered.Get(ctx, "missing")
+	require.ErrorIs(t, err, cache.ErrMiss)
+	calls := remote.calls
+
+	require.NoError(t, tiered.Set(ctx, "local", "L"))
+	got, err = tiered.Get(ctx, "local")
+	require.NoError(t, err)
+	assert.Equal(t, "L", got)
+	_, err = tiered.Get(ctx, "missing")
+	require.ErrorIs(t, err, cache.ErrMiss)
+
+	assert.Equal(t, calls, remote.calls)
+}
+
+func TestTiered_KeysTTLAndDelete(t *testing.T) {
+	ctx := context.Background()
+	l, err := logger.NewLogger("", "tiered_cache_admin_test")
+	require.NoError(t, err)
+
+	local := cache.NewLRU[string](10, time.Minute)
+	remote := &flakyCache{LRU: cache.NewLRU[string](10, time.Hour)}
+	tiered := cache.NewTiered[string](local, remote, l)
+
+	require.NoError(t, tiered.Set(ctx, "weather:kyiv-ua", "K"))
+	require.NoError(t, tiered.Set(ctx, "weather:lviv-ua", "L"))
+	require.NoError(t, tiered.Set(ctx, "forecast:kyiv-ua:3", "F"))
+
+	keys, err := tiered.Keys(ctx, "*kyiv*")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"weather:kyiv-ua", "forecast:kyiv-ua:3"}, keys)
+
+	// The remote expiry is the one that decides when an entry is gone.
+	ttl, err := tiered.TTL(ctx, "weather:kyiv-ua")
+	require.NoError(t, err)
+	assert.Greater(t, ttl, time.Minute)
+
+	// Deleting drops both tiers, so the value is not served from the local one.
+	require.NoError(t, tiered.Delete(ctx, "weather:kyiv-ua"))
+	_, err = tiered.Get(ctx, "weather:kyiv-ua")
+	require.ErrorIs(t, err, cache.ErrMiss)
+	_, err = local.Get(ctx, "weather:kyiv-ua")
+	require.ErrorIs(t, err, cache.ErrMiss)
+	_, err = tiered.TTL(ctx, "weather:kyiv-ua")
+	require.ErrorIs(t, err, cache.ErrMiss)
+
+	// While the remote tier is down, keys come from the local one and deletes fail.
+	remote.down = true
+	keys, err = tiered.Keys(ctx, "weather:*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"weather:lviv-ua"}, keys)
+	require.Error(t, tiered.Delete(ctx, "weather:lviv-ua"))
+}
@@ -0,0 +1,23 @@
+package cities
+
+import (
+	"math"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/geo"
+)
+
+// Nearest returns the catalog city closest to the point and its distance in
+// kilometres. The catalog is small enough to scan.
+func (r *Resolver) Nearest(lat, lon float64) (models.City, float64) {
+	var (
+		best     models.City
+		bestDist = math.Inf(1)
+	)
+	for _, e := range r.catalog {
+		if d := geo.Distance(lat, lon, e.city.Latitude, e.city.Longitude); d < bestDist {
+			best, bestDist = e.city, d
+		}
+	}
+	return best, bestDist
+}
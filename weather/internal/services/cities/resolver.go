@@ -0,0 +1,109 @@
+package cities
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+//go:embed cities.json
+var dataset []byte
+
+// Resolver maps free-form city input onto canonical cities from the embedded
+// dataset, matching names and aliases regardless of case, spacing, diacritics
+// and Cyrillic spelling.
+type Resolver struct {
+	byKey   map[string]models.City
+	catalog []entry
+}
+
+// entry is a dataset city with the normalized forms of its name and aliases.
+type entry struct {
+	city models.City
+	keys []string
+}
+
+// NewResolver builds a resolver over the embedded dataset.
+func NewResolver() (*Resolver, error) {
+	var list []models.City
+	if err := json.Unmarshal(dataset, &list); err != nil {
+		return nil, fmt.Errorf("decode city dataset: %w", err)
+	}
+
+	r := &Resolver{
+		byKey:   make(map[string]models.City, len(list)*4),
+		catalog: make([]entry, 0, len(list)),
+	}
+	for _, city := range list {
+		e := entry{city: city}
+		for _, name := range append([]string{city.Name}, city.Aliases...) {
+			key := Normalize(name)
+			if other, ok := r.byKey[key]; ok && other.ID != city.ID {
+				return nil, fmt.Errorf("city name %q is used by both %s and %s", name, other.ID, city.ID)
+			}
+			r.byKey[key] = city
+			e.keys = append(e.keys, key)
+		}
+		r.catalog = append(r.catalog, e)
+	}
+	return r, nil
+}
+
+// Resolve returns the canonical city for input. Cities missing from the dataset
+// still get an ID from the normalized Latin spelling, so "Біла Церква" and
+// "bila tserkva" share cache entries, and keep the input as their name.
+func (r *Resolver) Resolve(input string) models.City {
+	if city, ok := r.byKey[Normalize(input)]; ok {
+		return city
+	}
+	latin := Normalize(Transliterate(input))
+	if city, ok := r.byKey[latin]; ok {
+		return city
+	}
+	return models.City{
+		ID:   cityID(latin),
+		Name: strings.Join(strings.Fields(input), " "),
+	}
+}
+
+// cityID joins the words of a normalized name with hyphens. Characters that
+// separate or match parts of cache keys, such as "weather:<id>:<lang>" and the
+// patterns scanned to invalidate them, separate words too, so no input can
+// produce another city's key.
+func cityID(latin string) string {
+	return strings.Join(strings.FieldsFunc(latin, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(`:*?[]\`, r)
+	}), "-")
+}
+
+// Normalize lowercases s, strips diacritics and apostrophes, treats hyphens and
+// underscores as spaces and collapses whitespace.
+func Normalize(s string) string {
+	// Drop the combining marks left after canonical decomposition, turning
+	// "Kraków" into "Krakow". Chained transformers keep state, so build one per call.
+	stripMarks := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	stripped, _, err := transform.String(stripMarks, strings.ToLower(s))
+	if err != nil {
+		stripped = strings.ToLower(s)
+	}
+
+	var b strings.Builder
+	for _, r := range stripped {
+		switch r {
+		case '-', '_':
+			b.WriteRune(' ')
+		case '\'', '’', 'ʼ', '`':
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
@@ -0,0 +1,100 @@
+package cities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/cities"
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	r, err := cities.NewResolver()
+	require.NoError(t, err)
+
+	tests := []struct {
+		input  string
+		wantID string
+		want   string
+	}{
+		{input: "kyiv", wantID: "kyiv-ua", want: "Kyiv"},
+		{input: "Kyiv ", wantID: "kyiv-ua", want: "Kyiv"},
+		{input: "Kiev", wantID: "kyiv-ua", want: "Kyiv"},
+		{input: "Київ", wantID: "kyiv-ua", want: "Kyiv"},
+		{input: "  KRAKÓW", wantID: "krakow-pl", want: "Krakow"},
+		{input: "ivano frankivsk", wantID: "ivano-frankivsk-ua", want: "Ivano-Frankivsk"},
+		{input: "new   york", wantID: "new-york-us", want: "New York"},
+		// Not an alias in the dataset, matched through transliteration.
+		{input: "Житомир", wantID: "zhytomyr-ua", want: "Zhytomyr"},
+		{input: "Біла  Церква", wantID: "bila-tserkva", want: "Біла Церква"},
+		{input: "Springfield", wantID: "springfield", want: "Springfield"},
+		// Cache key separators and glob characters never reach an ID.
+		{input: "paris:uk", wantID: "paris-uk", want: "paris:uk"},
+		{input: "spring*field [old]?", wantID: "spring-field-old", want: "spring*field [old]?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			city := r.Resolve(tt.input)
+			assert.Equal(t, tt.wantID, city.ID)
+			assert.Equal(t, tt.want, city.Name)
+		})
+	}
+}
+
+func TestTransliterate(t *testing.T) {
+	assert.Equal(t, "kharkiv", cities.Transliterate("Харків"))
+	assert.Equal(t, "yizhakevych", cities.Transliterate("Їжакевич"))
+	assert.Equal(t, "zaporizhzhia", cities.Transliterate("Запоріжжя"))
+}
+
+func TestResolver_Search(t *testing.T) {
+	r, err := cities.NewResolver()
+	require.NoError(t, err)
+
+	names := func(prefix string, limit int) []string {
+		var names []string
+		for _, c := range r.Search(prefix, limit) {
+			names = append(names, c.Name)
+		}
+		return names
+	}
+
+	// Ordered by population among exact prefix matches.
+	assert.Equal(t, []string{"Chernihiv", "Cherkasy", "Chernivtsi"}, names("cher", 3))
+	assert.Equal(t, []string{"Chernihiv"}, names("cher", 1))
+	// Aliases, other scripts and diacritics.
+	assert.Equal(t, []string{"Kyiv"}, names("Киї", 10))
+	assert.Equal(t, []string{"Munich"}, names("münch", 10))
+	// Typos: one in a short prefix, two in a long one. Exact matches still come first.
+	assert.Equal(t, []string{"Lviv"}, names("lvi", 10))
+	assert.Equal(t, "Lviv", names("lviw", 10)[0])
+	assert.Equal(t, "Vienna", names("vien", 10)[0])
+	assert.Equal(t, []string{"Kharkiv"}, names("kharkyv", 1))
+	assert.Contains(t, names("zaporishia", 10), "Zaporizhzhia")
+	// Too short to be fuzzy.
+	assert.Empty(t, names("xyz", 10))
+	assert.Empty(t, names("  ", 10))
+
+	kyiv := r.Search("kyiv", 1)
+	require.Len(t, kyiv, 1)
+	assert.Equal(t, "Europe/Kyiv", kyiv[0].Timezone)
+	assert.InDelta(t, 50.45, kyiv[0].Latitude, 0.01)
+	assert.Positive(t, kyiv[0].Population)
+}
+
+func TestResolver_Nearest(t *testing.T) {
+	r, err := cities.NewResolver()
+	require.NoError(t, err)
+
+	// Lviv city hall, a few hundred metres from the dataset point.
+	city, km := r.Nearest(49.8419, 24.0316)
+	assert.Equal(t, "lviv-ua", city.ID)
+	assert.Less(t, km, 1.0)
+
+	// Somewhere between Kyiv and Zhytomyr, closer to Kyiv.
+	city, km = r.Nearest(50.40, 29.90)
+	assert.Equal(t, "kyiv-ua", city.ID)
+	assert.InDelta(t, 44, km, 5)
+}
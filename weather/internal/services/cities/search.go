@@ -0,0 +1,102 @@
+package cities
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+const (
+	// minFuzzyPrefix is the shortest prefix that tolerates typos; shorter ones
+	// match too much with even a single edit.
+	minFuzzyPrefix = 4
+	// longFuzzyPrefix is the prefix length from which two typos are tolerated.
+	longFuzzyPrefix = 6
+)
+
+type match struct {
+	city     models.City
+	distance int
+}
+
+// Search returns up to limit catalog cities whose name or alias starts with
+// prefix, tolerating a typo or two in longer prefixes. Exact prefix matches come
+// first, then closer fuzzy ones, each ordered by population.
+func (r *Resolver) Search(prefix string, limit int) []models.City {
+	query := Normalize(prefix)
+	if query == "" || limit <= 0 {
+		return nil
+	}
+	queries := []string{query}
+	if latin := Normalize(Transliterate(prefix)); latin != query {
+		queries = append(queries, latin)
+	}
+
+	var matches []match
+	for _, e := range r.catalog {
+		best := -1
+		for _, q := range queries {
+			for _, key := range e.keys {
+				if d := prefixDistance(q, key); d >= 0 && (best < 0 || d < best) {
+					best = d
+				}
+			}
+		}
+		if best >= 0 {
+			matches = append(matches, match{city: e.city, distance: best})
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b match) int {
+		return cmp.Or(
+			cmp.Compare(a.distance, b.distance),
+			cmp.Compare(b.city.Population, a.city.Population),
+			cmp.Compare(a.city.Name, b.city.Name),
+		)
+	})
+
+	result := make([]models.City, 0, min(limit, len(matches)))
+	for _, m := range matches[:min(limit, len(matches))] {
+		result = append(result, m.city)
+	}
+	return result
+}
+
+// prefixDistance is the edit distance between query and the closest prefix of
+// key, or -1 when it is more than the query length allows.
+func prefixDistance(query, key string) int {
+	q, k := []rune(query), []rune(key)
+
+	allowed := 0
+	switch {
+	case len(q) >= longFuzzyPrefix:
+		allowed = 2
+	case len(q) >= minFuzzyPrefix:
+		allowed = 1
+	}
+
+	// Row i holds the distances between q[:i] and every prefix of k; the answer is
+	// the best entry of the last row, since the rest of key is free.
+	prev := make([]int, len(k)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(q); i++ {
+		cur := make([]int, len(k)+1)
+		cur[0] = i
+		for j := 1; j <= len(k); j++ {
+			cost := 1
+			if q[i-1] == k[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+
+	if best := slices.Min(prev); best <= allowed {
+		return best
+	}
+	return -1
+}
@@ -0,0 +1,42 @@
+package cities
+
+import (
+	"strings"
+	"unicode"
+)
+
+// cyrillic follows the Ukrainian national transliteration; the few letters found
+// only in Russian get their closest Latin spelling.
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d", 'е': "e",
+	'є': "ie", 'ж': "zh", 'з': "z", 'и': "y", 'і': "i", 'ї': "i", 'й': "i",
+	'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch",
+	'ш': "sh", 'щ': "shch", 'ь': "", 'ю': "iu", 'я': "ia",
+	'ё': "e", 'ы': "y", 'э': "e", 'ъ': "",
+}
+
+// wordInitial holds the letters spelled differently at the start of a word.
+var wordInitial = map[rune]string{
+	'є': "ye", 'ї': "yi", 'й': "y", 'ю': "yu", 'я': "ya",
+}
+
+// Transliterate spells Cyrillic text in Latin letters, so "Київ" becomes "kyiv".
+// Other characters are kept as they are, lowercased.
+func Transliterate(s string) string {
+	var b strings.Builder
+	wordStart := true
+	for _, r := range strings.ToLower(s) {
+		latin, ok := cyrillic[r]
+		if initial, isInitial := wordInitial[r]; isInitial && wordStart {
+			latin = initial
+		}
+		if ok {
+			b.WriteString(latin)
+		} else {
+			b.WriteRune(r)
+		}
+		wordStart = !unicode.IsLetter(r) && r != '\'' && r != '’' && r != 'ʼ'
+	}
+	return b.String()
+}
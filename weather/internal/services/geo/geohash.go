@@ -0,0 +1,90 @@
+// Package geo holds the small amount of geometry the weather service needs:
+// geohash cells for bucketing nearby points and great-circle distances.
+package geo
+
+import (
+	"math"
+	"strings"
+)
+
+// base32 is the geohash alphabet.
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// earthRadiusKm is the mean Earth radius.
+const earthRadiusKm = 6371.0
+
+// Encode returns the geohash of the point with the given number of characters.
+// Points in the same cell share the hash; five characters make a cell of roughly
+// 5 x 5 km.
+func Encode(lat, lon float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+
+	var b strings.Builder
+	bits, ch := 0, 0
+	even := true
+	for b.Len() < precision {
+		// Bits alternate between longitude and latitude, longitude first.
+		r, v := &latRange, lat
+		if even {
+			r, v = &lonRange, lon
+		}
+		mid := (r[0] + r[1]) / 2
+		ch <<= 1
+		if v >= mid {
+			ch |= 1
+			r[0] = mid
+		} else {
+			r[1] = mid
+		}
+		even = !even
+
+		if bits++; bits == 5 {
+			b.WriteByte(base32[ch])
+			bits, ch = 0, 0
+		}
+	}
+	return b.String()
+}
+
+// Center returns the center of the geohash cell. Characters outside the
+// alphabet end the decoding early, giving the center of a larger cell.
+func Center(hash string) (lat, lon float64) {
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+
+	even := true
+	for _, c := range hash {
+		idx := strings.IndexRune(base32, c)
+		if idx < 0 {
+			break
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			r := &latRange
+			if even {
+				r = &lonRange
+			}
+			mid := (r[0] + r[1]) / 2
+			if idx&mask != 0 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+	return (latRange[0] + latRange[1]) / 2, (lonRange[0] + lonRange[1]) / 2
+}
+
+// Distance returns the great-circle distance between two points in kilometres.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	φ1, φ2 := radians(lat1), radians(lat2)
+	dφ, dλ := radians(lat2-lat1), radians(lon2-lon1)
+
+	a := math.Sin(dφ/2)*math.Sin(dφ/2) + math.Cos(φ1)*math.Cos(φ2)*math.Sin(dλ/2)*math.Sin(dλ/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
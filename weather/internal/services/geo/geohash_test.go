@@ -0,0 +1,38 @@
+package geo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/geo"
+)
+
+func TestEncode(t *testing.T) {
+	// Reference values from the geohash.org examples.
+	assert.Equal(t, "u4pruydqqvj", geo.Encode(57.64911, 10.40744, 11))
+	assert.Equal(t, "ezs42", geo.Encode(42.6, -5.6, 5))
+
+	// Nearby points share a cell; points a few cells apart do not.
+	assert.Equal(t, geo.Encode(49.8397, 24.0297, 5), geo.Encode(49.8410, 24.0310, 5))
+	assert.NotEqual(t, geo.Encode(49.8397, 24.0297, 5), geo.Encode(49.9500, 24.0297, 5))
+}
+
+func TestCenter(t *testing.T) {
+	lat, lon := geo.Center("ezs42")
+	assert.InDelta(t, 42.605, lat, 0.001)
+	assert.InDelta(t, -5.603, lon, 0.001)
+
+	// The center is inside the cell it came from.
+	hash := geo.Encode(50.45, 30.5233, 5)
+	lat, lon = geo.Center(hash)
+	assert.Equal(t, hash, geo.Encode(lat, lon, 5))
+}
+
+func TestDistance(t *testing.T) {
+	// Kyiv to Lviv.
+	assert.InDelta(t, 468, geo.Distance(50.4501, 30.5234, 49.8397, 24.0297), 5)
+	assert.InDelta(t, 0, geo.Distance(10, 20, 10, 20), 1e-9)
+	// Across the antimeridian.
+	assert.InDelta(t, 111.2, geo.Distance(0, 179.5, 0, -179.5), 0.5)
+}
@@ -0,0 +1,83 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+type observationStore interface {
+	Insert(ctx context.Context, obs models.Observation) error
+	Range(ctx context.Context, cityID string, from, to time.Time, bucket time.Duration) ([]models.HistoryPoint, error)
+	DeleteBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+type cityResolver interface {
+	Resolve(input string) models.City
+}
+
+// Service records provider observations and serves them back as history.
+type Service struct {
+	store    observationStore
+	resolver cityResolver
+	logger   zerolog.Logger
+}
+
+func NewService(store observationStore, resolver cityResolver, logger zerolog.Logger) *Service {
+	return &Service{
+		store:    store,
+		resolver: resolver,
+		logger:   logger.With().Str("component", "HistoryService").Logger(),
+	}
+}
+
+// Record stores weather just fetched for a city, keyed by its canonical ID.
+func (s *Service) Record(ctx context.Context, cityID string, data models.WeatherData) error {
+	return s.store.Insert(ctx, models.Observation{
+		CityID:    cityID,
+		Weather:   data,
+		FetchedAt: time.Now().UTC(),
+	})
+}
+
+// History returns what was observed in a city in [from, to) at the given
+// granularity, which the caller has validated.
+func (s *Service) History(
+	ctx context.Context,
+	input string,
+	from, to time.Time,
+	granularity string,
+) (models.History, error) {
+	city := s.resolver.Resolve(input)
+	bucket, _ := models.GranularityBucket(granularity)
+
+	points, err := s.store.Range(ctx, city.ID, from, to, bucket)
+	if err != nil {
+		return models.History{}, err
+	}
+	return models.History{City: city.Name, Granularity: granularity, Points: points}, nil
+}
+
+// RunRetention drops observations older than keep every interval until ctx is done.
+func (s *Service) RunRetention(ctx context.Context, keep, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.store.DeleteBefore(ctx, time.Now().Add(-keep))
+		if err != nil {
+			s.logger.Error().Err(err).Msg("failed to prune observations")
+		} else if deleted > 0 {
+			s.logger.Info().Int64("deleted", deleted).Msg("old observations pruned")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package logger
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Cassette modes.
+const (
+	ModeRecord = "record"
+	ModeReplay = "replay"
+)
+
+// redacted replaces secrets in recorded URLs.
+const redacted = "REDACTED"
+
+// ErrNotRecorded is returned in replay mode for a request with no cassette.
+var ErrNotRecorded = errors.New("request not recorded")
+
+// secretParams are query parameters that carry API keys. They are redacted
+// before a request is recorded or looked up, so cassettes hold no secrets and
+// replay with any key, or none. Request headers are not recorded at all.
+var secretParams = []string{"key", "appid", "apikey", "api_key", "access_key", "token"}
+
+// Cassette is a transport that records provider calls to a directory, one
+// JSON file per request, or replays them from it without touching the network.
+// It is meant to sit under RoundTripper as its Proxy, so calls are still logged.
+type Cassette struct {
+	Dir   string
+	Mode  string
+	Proxy http.RoundTripper // used in record mode
+	// Secrets are query parameters redacted on top of secretParams, such as
+	// those configured providers take their keys in.
+	Secrets []string
+}
+
+// interaction is what a cassette file holds.
+type interaction struct {
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Status     string      `json:"status"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       string      `json:"body"`
+	} `json:"response"`
+}
+
+// NewCassette creates a cassette transport over dir. In record mode the
+// directory is created and calls go through proxy. secrets name further query
+// parameters to redact.
+func NewCassette(dir, mode string, proxy http.RoundTripper, secrets ...string) (*Cassette, error) {
+	switch mode {
+	case ModeRecord:
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create cassette dir: %w", err)
+		}
+	case ModeReplay:
+		if _, err := os.Stat(dir); err != nil {
+			return nil, fmt.Errorf("open cassette dir: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %q, want %q or %q", mode, ModeRecord, ModeReplay)
+	}
+	return &Cassette{Dir: dir, Mode: mode, Proxy: proxy, Secrets: secrets}, nil
+}
+
+// RoundTrip records req and its response, or replays the recorded response.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := c.requestKey(req)
+	path := filepath.Join(c.Dir, cassetteName(req.URL.Host, req.Method, key))
+
+	if c.Mode == ModeReplay {
+		return replay(req, path, key)
+	}
+
+	resp, err := c.Proxy.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var rec interaction
+	rec.Request.Method = req.Method
+	rec.Request.URL = key
+	rec.Response.StatusCode = resp.StatusCode
+	rec.Response.Status = resp.Status
+	rec.Response.Header = resp.Header.Clone()
+	rec.Response.Header.Del("Set-Cookie")
+	rec.Response.Body = string(body)
+	if err := writeInteraction(path, rec); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func replay(req *http.Request, path, key string) (*http.Response, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, req.Method, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rec interaction
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return nil, fmt.Errorf("read cassette %s: %w", path, err)
+	}
+	return &http.Response{
+		StatusCode:    rec.Response.StatusCode,
+		Status:        rec.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        rec.Response.Header,
+		Body:          io.NopCloser(strings.NewReader(rec.Response.Body)),
+		ContentLength: int64(len(rec.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// writeInteraction writes rec through a temporary file, so a concurrent replay
+// never sees half a cassette.
+func writeInteraction(path string, rec interaction) error {
+	raw, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".cassette-*")
+	if err != nil {
+		return fmt.Errorf("write cassette: %w", err)
+	}
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write cassette: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write cassette: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// requestKey identifies a request by its URL with secrets redacted and query
+// parameters in a stable order.
+func (c *Cassette) requestKey(req *http.Request) string {
+	u := req.URL
+	q := u.Query()
+	for name := range q {
+		if isSecret(name, secretParams) || isSecret(name, c.Secrets) {
+			q.Set(name, redacted)
+		}
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: u.Path, RawQuery: q.Encode()}).String()
+}
+
+func isSecret(name string, secrets []string) bool {
+	return slices.ContainsFunc(secrets, func(secret string) bool { return strings.EqualFold(name, secret) })
+}
+
+// cassetteName names the file for a request: the host, for browsing, and a
+// hash of the method and key.
+func cassetteName(host, method, key string) string {
+	sum := sha256.Sum256([]byte(method + " " + key))
+	return strings.NewReplacer(":", "_", ".", "_").Replace(host) + "-" + hex.EncodeToString(sum[:8]) + ".json"
+}
@@ -0,0 +1,149 @@
+package logger_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/logger"
+)
+
+func get(t *testing.T, client *http.Client, url string) (*http.Response, string, error) {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body), nil
+}
+
+func TestCassette_RecordThenReplay(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("q") == "Atlantis" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "no such city"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"temp": 14.5}`))
+	}))
+	dir := filepath.Join(t.TempDir(), "cassettes")
+
+	recorder, err := logger.NewCassette(dir, logger.ModeRecord, http.DefaultTransport)
+	require.NoError(t, err)
+	client := &http.Client{Transport: recorder}
+
+	resp, body, err := get(t, client, srv.URL+"/weather?q=Lviv&appid=secret")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"temp": 14.5}`, body)
+
+	_, _, err = get(t, client, srv.URL+"/weather?q=Atlantis&appid=secret")
+	require.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	for _, f := range files {
+		raw, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		require.NoError(t, err)
+		assert.NotContains(t, string(raw), "secret")
+		assert.Contains(t, string(raw), "appid=REDACTED")
+	}
+
+	// Replay works with the provider gone and whatever key is configured.
+	srv.Close()
+	player, err := logger.NewCassette(dir, logger.ModeReplay, nil)
+	require.NoError(t, err)
+	client = &http.Client{Transport: player}
+
+	resp, body, err = get(t, client, srv.URL+"/weather?appid=other&q=Lviv")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `{"temp": 14.5}`, body)
+
+	resp, body, err = get(t, client, srv.URL+"/weather?q=Atlantis&appid=other")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, `{"error": "no such city"}`, body)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestCassette_ReplayUnknownRequest(t *testing.T) {
+	player, err := logger.NewCassette(t.TempDir(), logger.ModeReplay, nil)
+	require.NoError(t, err)
+
+	_, _, err = get(t, &http.Client{Transport: player}, "http://provider.test/weather?q=Lviv")
+	assert.ErrorIs(t, err, logger.ErrNotRecorded)
+}
+
+func TestNewCassette_Invalid(t *testing.T) {
+	_, err := logger.NewCassette(t.TempDir(), "rewind", nil)
+	assert.Error(t, err)
+
+	_, err = logger.NewCassette(filepath.Join(t.TempDir(), "missing"), logger.ModeReplay, nil)
+	assert.Error(t, err)
+}
+
+func TestCassette_UnderRoundTripper(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"temp": 14.5}`))
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+
+	recorder, err := logger.NewCassette(dir, logger.ModeRecord, http.DefaultTransport)
+	require.NoError(t, err)
+	rt := logger.NewRoundTripper(zap.NewNop())
+	rt.Proxy = recorder
+
+	_, body, err := get(t, &http.Client{Transport: rt}, srv.URL+"/weather")
+	require.NoError(t, err)
+	assert.Equal(t, `{"temp": 14.5}`, body)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestCassette_RedactsConfiguredSecrets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"temp": 14.5}`))
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+
+	recorder, err := logger.NewCassette(dir, logger.ModeRecord, http.DefaultTransport, "X-Acme-Token")
+	require.NoError(t, err)
+	_, _, err = get(t, &http.Client{Transport: recorder}, srv.URL+"/weather?q=Lviv&x-acme-token=secret")
+	require.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	raw, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "secret")
+	assert.Contains(t, string(raw), "REDACTED")
+
+	// Replay matches whatever key the provider is configured with.
+	player, err := logger.NewCassette(dir, logger.ModeReplay, nil, "X-Acme-Token")
+	require.NoError(t, err)
+	_, body, err := get(t, &http.Client{Transport: player}, srv.URL+"/weather?q=Lviv&x-acme-token=other")
+	require.NoError(t, err)
+	assert.Equal(t, `{"temp": 14.5}`, body)
+}
@@ -9,6 +9,7 @@ import (
 	grpc_prom "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/sony/gobreaker"
 	"google.golang.org/grpc"
 )
 
@@ -25,6 +26,20 @@ type Metrics struct {
 	// Domain metrics
 	WeatherRequestsTotal *prometheus.CounterVec
 	WeatherErrorsTotal   *prometheus.CounterVec
+
+	// Provider circuit breaker metrics
+	BreakerState            *prometheus.GaugeVec
+	BreakerTransitionsTotal *prometheus.CounterVec
+
+	// Hedged request metrics
+	HedgeResultsTotal *prometheus.CounterVec
+
+	// Provider quota metrics
+	QuotaRemaining    *prometheus.GaugeVec
+	ProviderCostTotal *prometheus.CounterVec
+
+	// Cache warm-up metrics
+	WarmupCitiesTotal *prometheus.CounterVec
 }
 
 // NewMetrics constructs and registers all weather-service metrics.
@@ -69,6 +84,60 @@ func NewMetrics(serviceName string) *Metrics {
 			[]string{"city", "error_type"},
 		),
 
+		BreakerState: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: serviceName,
+				Name:      "provider_breaker_state",
+				Help:      "Circuit breaker state per provider (0 closed, 1 half-open, 2 open)",
+			},
+			[]string{"provider"},
+		),
+
+		BreakerTransitionsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: serviceName,
+				Name:      "provider_breaker_transitions_total",
+				Help:      "Total number of circuit breaker state transitions per provider",
+			},
+			[]string{"provider", "from", "to"},
+		),
+
+		HedgeResultsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: serviceName,
+				Name:      "provider_hedge_results_total",
+				Help:      "Total number of hedged races won or lost per provider",
+			},
+			[]string{"provider", "result"},
+		),
+
+		QuotaRemaining: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: serviceName,
+				Name:      "provider_quota_remaining",
+				Help:      "Calls left in the current quota window per provider, shared across replicas",
+			},
+			[]string{"provider", "window"},
+		),
+
+		ProviderCostTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: serviceName,
+				Name:      "provider_calls_cost_total",
+				Help:      "Total configured cost of calls made to each provider by this replica",
+			},
+			[]string{"provider"},
+		),
+
+		WarmupCitiesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: serviceName,
+				Name:      "cache_warmup_cities_total",
+				Help:      "Total number of cities handled by cache warm-up, by outcome",
+			},
+			[]string{"result"},
+		),
+
 		// ServiceUptime: prometheus.NewGauge(
 		//	prometheus.GaugeOpts{
 		//		Namespace: serviceName,
@@ -93,6 +162,16 @@ func NewMetrics(serviceName string) *Metrics {
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
 
+	// Provider metrics go to the default registry so /metrics serves them next to the gRPC metrics.
+	prometheus.MustRegister(
+		m.BreakerState,
+		m.BreakerTransitionsTotal,
+		m.HedgeResultsTotal,
+		m.QuotaRemaining,
+		m.ProviderCostTotal,
+		m.WarmupCitiesTotal,
+	)
+
 	// enable grpc handling time histograms
 	grpc_prom.EnableHandlingTimeHistogram()
 
@@ -133,6 +212,41 @@ func (m *Metrics) HTTPMiddleware() gin.HandlerFunc {
 	}
 }
 
+// ObserveBreakerTransition records a provider's circuit breaker moving between states.
+func (m *Metrics) ObserveBreakerTransition(provider string, from, to gobreaker.State) {
+	m.ObserveBreakerState(provider, to)
+	m.BreakerTransitionsTotal.WithLabelValues(provider, from.String(), to.String()).Inc()
+}
+
+// ObserveBreakerState sets the state gauge of a provider's breaker.
+func (m *Metrics) ObserveBreakerState(provider string, state gobreaker.State) {
+	m.BreakerState.WithLabelValues(provider).Set(float64(state))
+}
+
+// ObserveHedgeResult records whether a provider won or lost a hedged race.
+func (m *Metrics) ObserveHedgeResult(provider string, won bool) {
+	result := "loss"
+	if won {
+		result = "win"
+	}
+	m.HedgeResultsTotal.WithLabelValues(provider, result).Inc()
+}
+
+// ObserveQuotaRemaining records the calls a provider has left in a quota window.
+func (m *Metrics) ObserveQuotaRemaining(provider, window string, left int64) {
+	m.QuotaRemaining.WithLabelValues(provider, window).Set(float64(left))
+}
+
+// ObserveCallCost records the cost of a call made to a provider.
+func (m *Metrics) ObserveCallCost(provider string, cost float64) {
+	m.ProviderCostTotal.WithLabelValues(provider).Add(cost)
+}
+
+// ObserveWarmup records the outcome of warming one city.
+func (m *Metrics) ObserveWarmup(result string) {
+	m.WarmupCitiesTotal.WithLabelValues(result).Inc()
+}
+
 // UnaryInterceptor returns a gRPC UnaryServerInterceptor for metrics.
 func (m *Metrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return grpc_prom.UnaryServerInterceptor
@@ -0,0 +1,41 @@
+package warmup
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/subs"
+)
+
+const scheduleTimeout = 10 * time.Second
+
+// SubscriptionsSource reads the notification schedule from the subscriptions service.
+type SubscriptionsSource struct {
+	client subs.SubscriptionServiceClient
+}
+
+func NewSubscriptionsSource(client subs.SubscriptionServiceClient) *SubscriptionsSource {
+	return &SubscriptionsSource{client: client}
+}
+
+func (s *SubscriptionsSource) Schedule(ctx context.Context) ([]Run, error) {
+	ctx, cancel := context.WithTimeout(ctx, scheduleTimeout)
+	defer cancel()
+
+	resp, err := s.client.GetNotificationSchedule(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]Run, 0, len(resp.GetRuns()))
+	for _, run := range resp.GetRuns() {
+		runs = append(runs, Run{
+			Frequency: run.GetFrequency(),
+			At:        run.GetNextRun().AsTime(),
+			Cities:    run.GetCities(),
+		})
+	}
+	return runs, nil
+}
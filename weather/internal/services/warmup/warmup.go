@@ -0,0 +1,200 @@
+package warmup
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Warm-up outcomes per city, as passed to Config.OnWarmed.
+const (
+	ResultWarmed  = "warmed"
+	ResultFresh   = "fresh"
+	ResultFailed  = "failed"
+	ResultSkipped = "skipped"
+)
+
+// Run is an upcoming notifier run and the cities it will need weather for.
+type Run struct {
+	Frequency string
+	At        time.Time
+	Cities    []string
+}
+
+type scheduleSource interface {
+	Schedule(ctx context.Context) ([]Run, error)
+}
+
+// cacheWarmer makes sure the weather of a city is cached and still fresh at until,
+// reporting whether that took a provider fetch.
+type cacheWarmer interface {
+	Warm(ctx context.Context, city string, until time.Time) (bool, error)
+}
+
+type Config struct {
+	// Lead is how long before a notifier run its cities are warmed.
+	Lead time.Duration
+	// Rate caps warm-up fetches per second so that provider quotas are not
+	// spent in a burst of their own.
+	Rate float64
+	// RetryAfter is how long to wait before asking for the schedule again
+	// after it could not be fetched or had no runs.
+	RetryAfter time.Duration
+	// OnWarmed, when set, is told the outcome for every city.
+	OnWarmed func(result string)
+}
+
+// Scheduler refreshes the cache for subscribed cities shortly before each
+// notifier run, so that the run finds every city cached instead of missing
+// for all of them at the same second.
+type Scheduler struct {
+	source scheduleSource
+	cache  cacheWarmer
+	cfg    Config
+	logger zerolog.Logger
+}
+
+func New(source scheduleSource, cache cacheWarmer, cfg Config, logger zerolog.Logger) *Scheduler {
+	return &Scheduler{
+		source: source,
+		cache:  cache,
+		cfg:    cfg,
+		logger: logger.With().Str("component", "WarmupScheduler").Logger(),
+	}
+}
+
+// Run warms the cache ahead of every notifier run until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.logger.Info().Dur("lead", s.cfg.Lead).Float64("rate", s.cfg.Rate).Msg("cache warm-up started")
+
+	for {
+		runs, err := s.source.Schedule(ctx)
+		if err != nil {
+			s.logger.Warn().Err(err).Msg("failed to fetch notification schedule")
+		}
+		at, ok := nextRun(runs)
+		if !ok {
+			if !sleep(ctx, s.cfg.RetryAfter) {
+				return
+			}
+			continue
+		}
+
+		if !sleep(ctx, time.Until(at.Add(-s.cfg.Lead))) {
+			return
+		}
+
+		// Subscriptions may have changed while waiting; fall back to the cities
+		// known so far if they cannot be fetched again.
+		if fresh, err := s.source.Schedule(ctx); err != nil {
+			s.logger.Warn().Err(err).Msg("failed to refetch notification schedule, using the previous one")
+		} else {
+			runs = fresh
+		}
+		s.Warm(ctx, citiesAt(runs, at), at)
+
+		// The schedule keeps reporting this run until it has started.
+		if !sleep(ctx, time.Until(at)) {
+			return
+		}
+	}
+}
+
+// Warm refreshes the given cities for a run at the given time, at most
+// Config.Rate cities per second. Cities it has no time left for before the run
+// are skipped.
+func (s *Scheduler) Warm(ctx context.Context, cities []string, at time.Time) {
+	start := time.Now()
+	ctx, cancel := context.WithDeadline(ctx, at)
+	defer cancel()
+
+	var interval time.Duration
+	if s.cfg.Rate > 0 {
+		interval = time.Duration(float64(time.Second) / s.cfg.Rate)
+	}
+
+	counts := make(map[string]int)
+	for i, city := range cities {
+		if i > 0 && !sleep(ctx, interval) {
+			for range cities[i:] {
+				s.observe(counts, ResultSkipped)
+			}
+			break
+		}
+
+		fetched, err := s.cache.Warm(ctx, city, at)
+		switch {
+		case err != nil:
+			s.logger.Warn().Str("city", city).Err(err).Msg("failed to warm city")
+			s.observe(counts, ResultFailed)
+		case fetched:
+			s.observe(counts, ResultWarmed)
+		default:
+			s.observe(counts, ResultFresh)
+		}
+	}
+
+	s.logger.Info().
+		Time("run_at", at).
+		Int("cities", len(cities)).
+		Int(ResultWarmed, counts[ResultWarmed]).
+		Int(ResultFresh, counts[ResultFresh]).
+		Int(ResultFailed, counts[ResultFailed]).
+		Int(ResultSkipped, counts[ResultSkipped]).
+		Dur("duration", time.Since(start)).
+		Msg("cache warm-up completed")
+}
+
+func (s *Scheduler) observe(counts map[string]int, result string) {
+	counts[result]++
+	if s.cfg.OnWarmed != nil {
+		s.cfg.OnWarmed(result)
+	}
+}
+
+// nextRun returns the time of the earliest run.
+func nextRun(runs []Run) (time.Time, bool) {
+	var at time.Time
+	for _, run := range runs {
+		if at.IsZero() || run.At.Before(at) {
+			at = run.At
+		}
+	}
+	return at, !at.IsZero()
+}
+
+// citiesAt returns the distinct cities of every run due at the given time;
+// hourly and daily runs often coincide.
+func citiesAt(runs []Run, at time.Time) []string {
+	seen := make(map[string]struct{})
+	var cities []string
+	for _, run := range runs {
+		if !run.At.Equal(at) {
+			continue
+		}
+		for _, city := range run.Cities {
+			if _, ok := seen[city]; ok {
+				continue
+			}
+			seen[city] = struct{}{}
+			cities = append(cities, city)
+		}
+	}
+	return cities
+}
+
+// sleep waits for d and reports whether ctx is still live afterwards.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,136 @@
+package warmup_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/warmup"
+)
+
+type fakeSource struct {
+	mu    sync.Mutex
+	runs  []warmup.Run
+	err   error
+	calls int
+}
+
+func (f *fakeSource) Schedule(context.Context) ([]warmup.Run, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return f.runs, f.err
+}
+
+type warmCall struct {
+	city  string
+	until time.Time
+	at    time.Time
+}
+
+type fakeWarmer struct {
+	mu     sync.Mutex
+	calls  []warmCall
+	failed map[string]bool
+	fresh  map[string]bool
+	done   chan struct{}
+	want   int
+}
+
+func (f *fakeWarmer) Warm(_ context.Context, city string, until time.Time) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, warmCall{city: city, until: until, at: time.Now()})
+	if len(f.calls) == f.want && f.done != nil {
+		close(f.done)
+	}
+	if f.failed[city] {
+		return false, errors.New("providers down")
+	}
+	return !f.fresh[city], nil
+}
+
+func TestScheduler_WarmsBeforeRun(t *testing.T) {
+	runAt := time.Now().Add(300 * time.Millisecond)
+	source := &fakeSource{runs: []warmup.Run{
+		{Frequency: "hourly", At: runAt, Cities: []string{"Kyiv", "Lviv"}},
+		{Frequency: "daily", At: runAt, Cities: []string{"Lviv", "Odesa"}},
+		{Frequency: "weekly", At: runAt.Add(time.Hour), Cities: []string{"Kharkiv"}},
+	}}
+	warmer := &fakeWarmer{done: make(chan struct{}), want: 3}
+
+	s := warmup.New(source, warmer, warmup.Config{
+		Lead:       200 * time.Millisecond,
+		Rate:       1000,
+		RetryAfter: time.Second,
+	}, zerolog.Nop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	select {
+	case <-warmer.done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("cities were not warmed")
+	}
+
+	warmer.mu.Lock()
+	defer warmer.mu.Unlock()
+	var cities []string
+	for _, call := range warmer.calls {
+		cities = append(cities, call.city)
+		assert.Equal(t, runAt, call.until)
+		assert.True(t, call.at.Before(runAt), "warmed after the run started")
+		assert.True(t, call.at.After(runAt.Add(-250*time.Millisecond)), "warmed too early")
+	}
+	assert.Equal(t, []string{"Kyiv", "Lviv", "Odesa"}, cities)
+}
+
+func TestScheduler_Warm(t *testing.T) {
+	warmer := &fakeWarmer{
+		failed: map[string]bool{"Atlantis": true},
+		fresh:  map[string]bool{"Lviv": true},
+	}
+	results := map[string]int{}
+
+	s := warmup.New(&fakeSource{}, warmer, warmup.Config{
+		Rate:     20,
+		OnWarmed: func(result string) { results[result]++ },
+	}, zerolog.Nop())
+
+	start := time.Now()
+	s.Warm(context.Background(), []string{"Kyiv", "Lviv", "Atlantis"}, time.Now().Add(time.Minute))
+
+	// Three cities at 20 per second take at least two 50ms gaps.
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	assert.Equal(t, map[string]int{
+		warmup.ResultWarmed: 1,
+		warmup.ResultFresh:  1,
+		warmup.ResultFailed: 1,
+	}, results)
+}
+
+func TestScheduler_WarmStopsAtRun(t *testing.T) {
+	warmer := &fakeWarmer{}
+	results := map[string]int{}
+
+	s := warmup.New(&fakeSource{}, warmer, warmup.Config{
+		Rate:     10,
+		OnWarmed: func(result string) { results[result]++ },
+	}, zerolog.Nop())
+
+	s.Warm(context.Background(), []string{"Kyiv", "Lviv", "Odesa", "Kharkiv"}, time.Now().Add(150*time.Millisecond))
+
+	require.Len(t, warmer.calls, 2)
+	assert.Equal(t, map[string]int{
+		warmup.ResultWarmed:  2,
+		warmup.ResultSkipped: 2,
+	}, results)
+}
@@ -0,0 +1,54 @@
+package watch
+
+import (
+	"sync"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// Hub fans freshly cached weather out to everyone watching the same city.
+type Hub struct {
+	mu       sync.Mutex
+	watchers map[string]map[chan models.WeatherData]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{watchers: make(map[string]map[chan models.WeatherData]struct{})}
+}
+
+// Subscribe registers interest in a city. The returned channel holds at most the
+// latest update, so a slow watcher never blocks publishers. Call the returned
+// function to unsubscribe.
+func (h *Hub) Subscribe(city string) (<-chan models.WeatherData, func()) {
+	ch := make(chan models.WeatherData, 1)
+
+	h.mu.Lock()
+	if h.watchers[city] == nil {
+		h.watchers[city] = make(map[chan models.WeatherData]struct{})
+	}
+	h.watchers[city][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.watchers[city], ch)
+		if len(h.watchers[city]) == 0 {
+			delete(h.watchers, city)
+		}
+	}
+}
+
+// Publish delivers data to every watcher of city, replacing any update they have not read yet.
+func (h *Hub) Publish(city string, data models.WeatherData) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.watchers[city] {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- data
+	}
+}
@@ -0,0 +1,36 @@
+package watch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/watch"
+)
+
+func TestHub_PublishKeepsLatestPerWatcher(t *testing.T) {
+	hub := watch.NewHub()
+
+	kyiv, stopKyiv := hub.Subscribe("Kyiv")
+	defer stopKyiv()
+	lviv, stopLviv := hub.Subscribe("Lviv")
+	defer stopLviv()
+
+	hub.Publish("Kyiv", models.WeatherData{City: "Kyiv", Temperature: 10})
+	hub.Publish("Kyiv", models.WeatherData{City: "Kyiv", Temperature: 12})
+
+	assert.Equal(t, 12.0, (<-kyiv).Temperature)
+	assert.Empty(t, lviv)
+}
+
+func TestHub_Unsubscribe(t *testing.T) {
+	hub := watch.NewHub()
+
+	updates, stop := hub.Subscribe("Kyiv")
+	stop()
+
+	hub.Publish("Kyiv", models.WeatherData{City: "Kyiv"})
+
+	assert.Empty(t, updates)
+}
@@ -0,0 +1,167 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+const (
+	providerAggregate = "aggregate"
+
+	breakerOpen = "open"
+)
+
+// aggregate queries every healthy client in parallel and combines the readings:
+// median for the numeric fields, majority vote for the condition.
+func (s *ServiceProvider) aggregate(ctx context.Context, q query) (models.WeatherData, error) {
+	healthy, skipped := s.healthyClients()
+
+	readings := make([]models.WeatherData, len(healthy))
+	errs := make([]error, len(healthy))
+
+	var wg sync.WaitGroup
+	for i, idx := range healthy {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			readings[i], errs[i] = s.fetch(ctx, idx, q)
+		}()
+	}
+	wg.Wait()
+
+	var ok []models.WeatherData
+	failed := skipped
+	for i, err := range errs {
+		if err != nil {
+			s.logger.Error().
+				Ctx(ctx).
+				Str("client", clientName(s.clients[healthy[i]])).
+				Err(err).
+				Msg("aggregate fetch failed")
+			failed = append(failed, err)
+			continue
+		}
+		ok = append(ok, readings[i])
+	}
+
+	if len(ok) == 0 {
+		err := fmt.Errorf("all weather API clients failed: %w", dominantError(failed))
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("location", q.location).
+			Msg("aggregate lookup giving up")
+		return models.WeatherData{}, err
+	}
+
+	data := combine(ok)
+	s.logger.Info().
+		Ctx(ctx).
+		Str("location", q.location).
+		Strs("contributors", data.Contributors).
+		Float64("temperature_spread", data.TemperatureSpread).
+		Msg("aggregate fetch succeeded")
+	return data, nil
+}
+
+// healthyClients returns the indexes of clients whose breaker is not open; open
+// ones would fail fast anyway. Clients with a spent budget are left out too, and
+// a quota error is returned for each of them.
+func (s *ServiceProvider) healthyClients() ([]int, []error) {
+	healthy := make([]int, 0, len(s.clients))
+	var skipped []error
+	for i, cl := range s.clients {
+		if r, ok := cl.(statusReporter); ok && r.Status().State == breakerOpen {
+			continue
+		}
+		if s.exhausted(i) {
+			skipped = append(skipped, quotaError(cl))
+			continue
+		}
+		healthy = append(healthy, i)
+	}
+	return healthy, skipped
+}
+
+// combine merges readings given in client order. Wind direction is circular, so
+// it is taken from the first reading rather than averaged.
+func combine(readings []models.WeatherData) models.WeatherData {
+	first := readings[0]
+	condition := majority(readings)
+	data := models.WeatherData{
+		City:          first.City,
+		Condition:     condition.Condition,
+		ConditionCode: condition.ConditionCode,
+		WindDirection: first.WindDirection,
+		ObservedAt:    first.ObservedAt,
+		Provider:      providerAggregate,
+	}
+
+	temps := make([]float64, 0, len(readings))
+	feels := make([]float64, 0, len(readings))
+	humidity := make([]float64, 0, len(readings))
+	wind := make([]float64, 0, len(readings))
+	pressure := make([]float64, 0, len(readings))
+	for _, r := range readings {
+		temps = append(temps, r.Temperature)
+		feels = append(feels, r.FeelsLike)
+		humidity = append(humidity, float64(r.Humidity))
+		wind = append(wind, r.WindSpeed)
+		pressure = append(pressure, r.Pressure)
+		data.Contributors = append(data.Contributors, r.Provider)
+		if r.ObservedAt.After(data.ObservedAt) {
+			data.ObservedAt = r.ObservedAt
+		}
+	}
+
+	data.Temperature = median(temps)
+	data.FeelsLike = median(feels)
+	data.Humidity = int(median(humidity))
+	data.WindSpeed = median(wind)
+	data.Pressure = median(pressure)
+	data.TemperatureSpread = slices.Max(temps) - slices.Min(temps)
+
+	return data
+}
+
+func median(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// majority returns the reading with the most common condition. Providers word
+// the same sky differently, so readings vote by canonical condition where they
+// have one and by case-insensitive text otherwise. Ties go to the condition
+// reported first, and the first reading with it is kept.
+func majority(readings []models.WeatherData) models.WeatherData {
+	votes := make(map[string]int, len(readings))
+	for _, r := range readings {
+		votes[conditionVote(r)]++
+	}
+
+	best, bestVotes := models.WeatherData{}, 0
+	for _, r := range readings {
+		if n := votes[conditionVote(r)]; n > bestVotes {
+			best, bestVotes = r, n
+		}
+	}
+	return best
+}
+
+func conditionVote(r models.WeatherData) string {
+	if r.ConditionCode != "" && r.ConditionCode != models.ConditionUnknown {
+		return string(r.ConditionCode)
+	}
+	return "text:" + strings.ToLower(r.Condition)
+}
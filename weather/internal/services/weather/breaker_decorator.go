@@ -2,7 +2,9 @@ package weather
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -16,16 +18,26 @@ type BreakerConfig struct {
 	TimeInterval time.Duration
 	TimeTimeOut  time.Duration
 	RepeatNumber uint32
+
+	// OnStateChange, when set, is called on every breaker state transition.
+	OnStateChange func(name string, from, to gobreaker.State)
+	// OnStart, when set, is told the initial state of every breaker created.
+	OnStart func(name string, state gobreaker.State)
 }
 
 // BreakerClient wraps another weather client with a circuit breaker and structured logging.
 type BreakerClient struct {
 	cb      *gobreaker.CircuitBreaker
-	wrapped client
+	wrapped Client
 	logger  zerolog.Logger
+
+	mu            sync.Mutex
+	lastError     string
+	lastErrorAt   time.Time
+	lastSuccessAt time.Time
 }
 
-func NewBreakerClient(name string, cfg BreakerConfig, logger zerolog.Logger, wrapped client) *BreakerClient {
+func NewBreakerClient(name string, cfg BreakerConfig, logger zerolog.Logger, wrapped Client) *BreakerClient {
 	settings := gobreaker.Settings{
 		Name:        name,
 		MaxRequests: 1,
@@ -34,13 +46,87 @@ func NewBreakerClient(name string, cfg BreakerConfig, logger zerolog.Logger, wra
 		ReadyToTrip: func(counts gobreaker.Counts) bool {
 			return counts.ConsecutiveFailures >= cfg.RepeatNumber
 		},
+		IsSuccessful: isHealthy,
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			logger.Warn().
+				Str("breaker_name", name).
+				Str("from", from.String()).
+				Str("to", to.String()).
+				Msg("circuit breaker: state changed")
+			if cfg.OnStateChange != nil {
+				cfg.OnStateChange(name, from, to)
+			}
+		},
 	}
 	cb := gobreaker.NewCircuitBreaker(settings)
+	if cfg.OnStart != nil {
+		cfg.OnStart(name, cb.State())
+	}
 	return &BreakerClient{cb: cb, wrapped: wrapped, logger: logger}
 }
 
+// Name returns the provider name the breaker was created with.
+func (b *BreakerClient) Name() string {
+	return b.cb.Name()
+}
+
+// isHealthy decides what counts as a breaker success. An unknown city, a lookup
+// the provider is not set up for, a call refused by our own budget or one
+// cancelled by the caller, such as the loser of a hedged race, says nothing
+// about the provider's health.
+func isHealthy(err error) bool {
+	return err == nil ||
+		errors.Is(err, models.ErrCityNotFound) ||
+		errors.Is(err, errUnsupported) ||
+		errors.Is(err, errBudgetSpent) ||
+		errors.Is(err, context.Canceled)
+}
+
+// Exhausted reports whether the wrapped client has spent its call budget.
+func (b *BreakerClient) Exhausted() bool {
+	return exhausted(b.wrapped)
+}
+
+// Status reports the breaker state, its counts for the current interval and the
+// most recent failure and success.
+func (b *BreakerClient) Status() models.ProviderStatus {
+	counts := b.cb.Counts()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return models.ProviderStatus{
+		Name:                 b.cb.Name(),
+		State:                b.cb.State().String(),
+		Requests:             counts.Requests,
+		TotalSuccesses:       counts.TotalSuccesses,
+		TotalFailures:        counts.TotalFailures,
+		ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
+		ConsecutiveFailures:  counts.ConsecutiveFailures,
+		LastError:            b.lastError,
+		LastErrorAt:          b.lastErrorAt,
+		LastSuccessAt:        b.lastSuccessAt,
+	}
+}
+
+// record remembers the outcome of a call for Status. Failures are kept as a
+// summary: Status is served to admins, and raw transport errors carry the
+// provider URL with its API key.
+func (b *BreakerClient) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case err == nil:
+		b.lastSuccessAt = time.Now().UTC()
+	case !isHealthy(err):
+		b.lastError = failureSummary(err)
+		b.lastErrorAt = time.Now().UTC()
+	}
+}
+
 // Fetch executes the wrapped client's Fetch under the circuit breaker, logging entry, exit, and errors.
-func (b *BreakerClient) Fetch(ctx context.Context, city string) (models.WeatherData, error) {
+func (b *BreakerClient) Fetch(ctx context.Context, city, lang string) (models.WeatherData, error) {
 	start := time.Now()
 	b.logger.Debug().
 		Ctx(ctx).
@@ -49,8 +135,10 @@ func (b *BreakerClient) Fetch(ctx context.Context, city string) (models.WeatherD
 		Msg("circuit breaker: starting request")
 
 	result, err := b.cb.Execute(func() (interface{}, error) {
-		return b.wrapped.Fetch(ctx, city)
+		return b.wrapped.Fetch(ctx, city, lang)
 	})
+	err = b.classify(err)
+	b.record(err)
 	duration := time.Since(start)
 	if err != nil {
 		b.logger.Error().
@@ -82,3 +170,106 @@ func (b *BreakerClient) Fetch(ctx context.Context, city string) (models.WeatherD
 		Msg("circuit breaker: request succeeded")
 	return res, nil
 }
+
+// FetchByCoordinates executes the wrapped client's FetchByCoordinates under the same circuit breaker as Fetch.
+func (b *BreakerClient) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	start := time.Now()
+	b.logger.Debug().
+		Ctx(ctx).
+		Str("breaker_name", b.cb.Name()).
+		Float64("lat", lat).
+		Float64("lon", lon).
+		Msg("circuit breaker: starting coordinates request")
+
+	result, err := b.cb.Execute(func() (interface{}, error) {
+		return b.wrapped.FetchByCoordinates(ctx, lat, lon)
+	})
+	err = b.classify(err)
+	b.record(err)
+	duration := time.Since(start)
+	if err != nil {
+		b.logger.Error().
+			Ctx(ctx).
+			Str("breaker_name", b.cb.Name()).
+			Float64("lat", lat).
+			Float64("lon", lon).
+			Dur("duration_ms", duration).
+			Err(err).
+			Msg("circuit breaker: coordinates request failed")
+		return models.WeatherData{}, err
+	}
+
+	res, ok := result.(models.WeatherData)
+	if !ok {
+		b.logger.Error().
+			Ctx(ctx).
+			Str("breaker_name", b.cb.Name()).
+			Dur("duration_ms", duration).
+			Msg("circuit breaker: unexpected result type")
+		return models.WeatherData{}, fmt.Errorf("returned unexpected result type: %T", result)
+	}
+
+	b.logger.Info().
+		Ctx(ctx).
+		Str("breaker_name", b.cb.Name()).
+		Float64("lat", lat).
+		Float64("lon", lon).
+		Dur("duration_ms", duration).
+		Msg("circuit breaker: coordinates request succeeded")
+	return res, nil
+}
+
+// FetchForecast executes the wrapped client's FetchForecast under the same circuit breaker as Fetch.
+func (b *BreakerClient) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	start := time.Now()
+	b.logger.Debug().
+		Ctx(ctx).
+		Str("breaker_name", b.cb.Name()).
+		Str("city", city).
+		Int("days", days).
+		Msg("circuit breaker: starting forecast request")
+
+	result, err := b.cb.Execute(func() (interface{}, error) {
+		return b.wrapped.FetchForecast(ctx, city, days)
+	})
+	err = b.classify(err)
+	b.record(err)
+	duration := time.Since(start)
+	if err != nil {
+		b.logger.Error().
+			Ctx(ctx).
+			Str("breaker_name", b.cb.Name()).
+			Str("city", city).
+			Dur("duration_ms", duration).
+			Err(err).
+			Msg("circuit breaker: forecast request failed")
+		return models.Forecast{}, err
+	}
+
+	res, ok := result.(models.Forecast)
+	if !ok {
+		b.logger.Error().
+			Ctx(ctx).
+			Str("breaker_name", b.cb.Name()).
+			Str("city", city).
+			Dur("duration_ms", duration).
+			Msg("circuit breaker: unexpected result type")
+		return models.Forecast{}, fmt.Errorf("returned unexpected result type: %T", result)
+	}
+
+	b.logger.Info().
+		Ctx(ctx).
+		Str("breaker_name", b.cb.Name()).
+		Str("city", city).
+		Dur("duration_ms", duration).
+		Msg("circuit breaker: forecast request succeeded")
+	return res, nil
+}
+
+// classify reports requests rejected by the breaker itself as the provider being unavailable.
+func (b *BreakerClient) classify(err error) error {
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		return models.NewProviderError(b.cb.Name(), models.ErrProviderUnavailable, err)
+	}
+	return err
+}
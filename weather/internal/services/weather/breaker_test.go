@@ -3,6 +3,7 @@ package weather_test
 import (
 	"context"
 	"errors"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/sony/gobreaker"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -27,7 +29,7 @@ type mockWrapped struct {
 	mock.Mock
 }
 
-func (m *mockWrapped) Fetch(ctx context.Context, city string) (models.WeatherData, error) {
+func (m *mockWrapped) Fetch(ctx context.Context, city, _ string) (models.WeatherData, error) {
 	args := m.Called(ctx, city)
 	data, ok := args.Get(0).(models.WeatherData)
 	if !ok {
@@ -36,6 +38,24 @@ func (m *mockWrapped) Fetch(ctx context.Context, city string) (models.WeatherDat
 	return data, args.Error(1)
 }
 
+func (m *mockWrapped) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	args := m.Called(ctx, lat, lon)
+	data, ok := args.Get(0).(models.WeatherData)
+	if !ok {
+		return models.WeatherData{}, args.Error(1)
+	}
+	return data, args.Error(1)
+}
+
+func (m *mockWrapped) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	args := m.Called(ctx, city, days)
+	data, ok := args.Get(0).(models.Forecast)
+	if !ok {
+		return models.Forecast{}, args.Error(1)
+	}
+	return data, args.Error(1)
+}
+
 const (
 	breakerName = "TestAPI"
 	city        = "Lviv"
@@ -55,7 +75,7 @@ func TestBreakerClient_Success(t *testing.T) {
 
 	bc := weather.NewBreakerClient(breakerName, breakerCfg, l, wrapped)
 
-	data, err := bc.Fetch(context.Background(), city)
+	data, err := bc.Fetch(context.Background(), city, "")
 	assert.NoError(t, err)
 	assert.Equal(t, expected, data)
 
@@ -77,7 +97,7 @@ func TestBreakerClient_UnderlyingErrorBeforeTrip(t *testing.T) {
 
 	bc := weather.NewBreakerClient(breakerName, breakerCfg, l, wrapped)
 
-	data, err := bc.Fetch(context.Background(), city)
+	data, err := bc.Fetch(context.Background(), city, "")
 	assert.Error(t, err)
 	assert.Empty(t, data)
 	assert.Contains(t, err.Error(), underlyingErr.Error())
@@ -103,12 +123,12 @@ func TestBreakerClient_TripCircuitAfterFiveFailures(t *testing.T) {
 	bc := weather.NewBreakerClient(breakerName, breakerCfg, l, wrapped)
 
 	for i := 1; i <= 5; i++ {
-		_, err := bc.Fetch(context.Background(), city)
+		_, err := bc.Fetch(context.Background(), city, "")
 		assert.Error(t, err, "call #%d should error before trip", i)
 		assert.Contains(t, err.Error(), underlyingErr.Error())
 	}
 
-	_, err = bc.Fetch(context.Background(), city)
+	_, err = bc.Fetch(context.Background(), city, "")
 	assert.Error(t, err)
 	assert.True(t,
 		strings.Contains(err.Error(), "circuit breaker is open"),
@@ -118,3 +138,185 @@ func TestBreakerClient_TripCircuitAfterFiveFailures(t *testing.T) {
 	wrapped.AssertExpectations(t)
 	wrapped.AssertNumberOfCalls(t, "Fetch", 5)
 }
+
+func TestBreakerClient_ForecastSharesBreaker(t *testing.T) {
+	wrapped := new(mockWrapped)
+	underlyingErr := errors.New("timeout")
+
+	wrapped.
+		On("Fetch", mock.Anything, city).
+		Return(models.WeatherData{}, underlyingErr).
+		Times(5)
+
+	l, err := logger.NewLogger("", "breaker_test_forecast_shared")
+	require.NoError(t, err)
+
+	bc := weather.NewBreakerClient(breakerName, breakerCfg, l, wrapped)
+
+	for i := 0; i < 5; i++ {
+		_, err := bc.Fetch(context.Background(), city, "")
+		require.Error(t, err)
+	}
+
+	_, err = bc.FetchForecast(context.Background(), city, 3)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "circuit breaker is open")
+
+	wrapped.AssertExpectations(t)
+	wrapped.AssertNumberOfCalls(t, "FetchForecast", 0)
+}
+
+func TestBreakerClient_CityNotFoundDoesNotTrip(t *testing.T) {
+	wrapped := new(mockWrapped)
+	notFound := models.NewProviderError(breakerName, models.ErrCityNotFound, errors.New("status 404 Not Found"))
+
+	wrapped.
+		On("Fetch", mock.Anything, city).
+		Return(models.WeatherData{}, notFound).
+		Times(6)
+
+	l, err := logger.NewLogger("", "breaker_test_not_found")
+	require.NoError(t, err)
+
+	bc := weather.NewBreakerClient(breakerName, breakerCfg, l, wrapped)
+
+	for i := 0; i < 6; i++ {
+		_, err := bc.Fetch(context.Background(), city, "")
+		assert.ErrorIs(t, err, models.ErrCityNotFound)
+	}
+
+	wrapped.AssertExpectations(t)
+}
+
+func TestBreakerClient_OpenCircuitIsUnavailable(t *testing.T) {
+	wrapped := new(mockWrapped)
+
+	wrapped.
+		On("Fetch", mock.Anything, city).
+		Return(models.WeatherData{}, errors.New("timeout")).
+		Times(5)
+
+	l, err := logger.NewLogger("", "breaker_test_open_unavailable")
+	require.NoError(t, err)
+
+	bc := weather.NewBreakerClient(breakerName, breakerCfg, l, wrapped)
+
+	for i := 0; i < 5; i++ {
+		_, err := bc.Fetch(context.Background(), city, "")
+		require.Error(t, err)
+	}
+
+	_, err = bc.Fetch(context.Background(), city, "")
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
+
+	var providerErr *models.ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.Equal(t, breakerName, providerErr.Provider)
+}
+
+func TestBreakerClient_StatusAndTransitions(t *testing.T) {
+	wrapped := new(mockWrapped)
+	underlyingErr := models.NewProviderError(breakerName, models.ErrProviderUnavailable, errors.New("boom"))
+
+	wrapped.
+		On("Fetch", mock.Anything, city).
+		Return(models.WeatherData{City: city}, nil).
+		Once()
+	wrapped.
+		On("Fetch", mock.Anything, city).
+		Return(models.WeatherData{}, underlyingErr).
+		Times(5)
+
+	var transitions []string
+	cfg := breakerCfg
+	cfg.OnStateChange = func(name string, from, to gobreaker.State) {
+		transitions = append(transitions, name+":"+from.String()+"->"+to.String())
+	}
+	cfg.OnStart = func(name string, state gobreaker.State) {
+		transitions = append(transitions, name+":"+state.String())
+	}
+
+	l, err := logger.NewLogger("", "breaker_test_status")
+	require.NoError(t, err)
+
+	bc := weather.NewBreakerClient(breakerName, cfg, l, wrapped)
+
+	_, err = bc.Fetch(context.Background(), city, "")
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err := bc.Fetch(context.Background(), city, "")
+		require.Error(t, err)
+	}
+
+	status := bc.Status()
+	assert.Equal(t, breakerName, status.Name)
+	assert.Equal(t, "open", status.State)
+	assert.Equal(t, "provider unavailable", status.LastError)
+	assert.False(t, status.LastErrorAt.IsZero())
+	assert.False(t, status.LastSuccessAt.IsZero())
+	assert.Equal(t, []string{breakerName + ":closed", breakerName + ":closed->open"}, transitions)
+
+	wrapped.AssertExpectations(t)
+}
+
+func TestBreakerClient_StatusRedactsLastError(t *testing.T) {
+	wrapped := new(mockWrapped)
+	transportErr := &url.Error{
+		Op:  "Get",
+		URL: "https://api.test/v1/current.json?key=secret&q=Lviv",
+		Err: errors.New("connection refused"),
+	}
+	wrapped.
+		On("Fetch", mock.Anything, city).
+		Return(models.WeatherData{}, models.NewProviderError(breakerName, models.ErrProviderUnavailable, transportErr)).
+		Once()
+
+	l, err := logger.NewLogger("", "breaker_test_redact")
+	require.NoError(t, err)
+
+	bc := weather.NewBreakerClient(breakerName, breakerCfg, l, wrapped)
+	_, err = bc.Fetch(context.Background(), city, "")
+	require.Error(t, err)
+
+	status := bc.Status()
+	assert.Equal(t, "provider unavailable: Get https://api.test/v1/current.json failed", status.LastError)
+	assert.NotContains(t, status.LastError, "secret")
+}
+
+// stalledClient answers only once its caller gives up, with the transport error
+// a cancelled HTTP request produces.
+type stalledClient struct {
+	mockWrapped
+}
+
+func (s *stalledClient) Fetch(ctx context.Context, _, _ string) (models.WeatherData, error) {
+	<-ctx.Done()
+	return models.WeatherData{}, models.NewProviderError(breakerName, models.ErrProviderUnavailable,
+		&url.Error{Op: "Get", URL: "https://api.test/v1/current.json", Err: ctx.Err()})
+}
+
+func TestBreakerClient_HedgedLosersDoNotTrip(t *testing.T) {
+	l, err := logger.NewLogger("", "breaker_test_hedged_losers")
+	require.NoError(t, err)
+
+	slow := weather.NewBreakerClient(breakerName, breakerCfg, l, &stalledClient{})
+	fast := new(mockWrapped)
+	fast.On("Fetch", mock.Anything, city).Return(models.WeatherData{City: city}, nil)
+
+	svc := weather.NewService(l, weather.StrategyConfig{
+		Strategy:   weather.StrategyHedge,
+		HedgeDelay: 10 * time.Millisecond,
+	}, slow, fast)
+
+	for i := 0; i < int(breakerCfg.RepeatNumber)+1; i++ {
+		_, err := svc.GetByCity(context.Background(), city, "")
+		require.NoError(t, err)
+	}
+	// The losers are cancelled as the winners return; give them time to finish.
+	time.Sleep(50 * time.Millisecond)
+
+	status := slow.Status()
+	assert.Equal(t, gobreaker.StateClosed.String(), status.State)
+	assert.Zero(t, status.TotalFailures)
+	assert.Empty(t, status.LastError)
+}
@@ -0,0 +1,168 @@
+package weather
+
+import "github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+
+// Providers are mapped onto canonical conditions by their numeric condition
+// codes rather than their text, which is localized when a language is asked for.
+
+// codeRange maps an inclusive range of provider condition codes onto a condition.
+type codeRange struct {
+	from, to  int
+	condition models.Condition
+}
+
+// openWeatherConditions maps OpenWeatherMap condition IDs, see
+// https://openweathermap.org/weather-conditions. Exceptions precede the range
+// they fall in.
+var openWeatherConditions = []codeRange{
+	{200, 299, models.ConditionThunderstorm},
+	{300, 399, models.ConditionDrizzle},
+	{511, 511, models.ConditionSleet}, // freezing rain
+	{500, 599, models.ConditionRain},
+	{611, 616, models.ConditionSleet},
+	{600, 699, models.ConditionSnow},
+	{700, 799, models.ConditionFog}, // mist, haze, dust and the like
+	{800, 800, models.ConditionClear},
+	{801, 802, models.ConditionPartlyCloudy},
+	{803, 804, models.ConditionCloudy},
+}
+
+// weatherBitConditions maps WeatherBit weather codes, see
+// https://www.weatherbit.io/api/codes. Exceptions precede the range they fall in.
+var weatherBitConditions = []codeRange{
+	{200, 299, models.ConditionThunderstorm},
+	{300, 399, models.ConditionDrizzle},
+	{511, 511, models.ConditionSleet}, // freezing rain
+	{500, 599, models.ConditionRain},
+	{610, 612, models.ConditionSleet},
+	{600, 699, models.ConditionSnow},
+	{700, 799, models.ConditionFog},
+	{800, 800, models.ConditionClear},
+	{801, 802, models.ConditionPartlyCloudy},
+	{803, 804, models.ConditionCloudy},
+}
+
+// weatherAPIConditions maps WeatherAPI condition codes, see
+// https://www.weatherapi.com/docs/weather_conditions.json.
+var weatherAPIConditions = map[int]models.Condition{
+	1000: models.ConditionClear,
+	1003: models.ConditionPartlyCloudy,
+	1006: models.ConditionCloudy,
+	1009: models.ConditionCloudy,
+	1030: models.ConditionFog,
+	1063: models.ConditionRain,
+	1066: models.ConditionSnow,
+	1069: models.ConditionSleet,
+	1072: models.ConditionDrizzle,
+	1087: models.ConditionThunderstorm,
+	1114: models.ConditionSnow,
+	1117: models.ConditionSnow,
+	1135: models.ConditionFog,
+	1147: models.ConditionFog,
+	1150: models.ConditionDrizzle,
+	1153: models.ConditionDrizzle,
+	1168: models.ConditionDrizzle,
+	1171: models.ConditionDrizzle,
+	1180: models.ConditionRain,
+	1183: models.ConditionRain,
+	1186: models.ConditionRain,
+	1189: models.ConditionRain,
+	1192: models.ConditionRain,
+	1195: models.ConditionRain,
+	1198: models.ConditionSleet,
+	1201: models.ConditionSleet,
+	1204: models.ConditionSleet,
+	1207: models.ConditionSleet,
+	1210: models.ConditionSnow,
+	1213: models.ConditionSnow,
+	1216: models.ConditionSnow,
+	1219: models.ConditionSnow,
+	1222: models.ConditionSnow,
+	1225: models.ConditionSnow,
+	1237: models.ConditionSleet,
+	1240: models.ConditionRain,
+	1243: models.ConditionRain,
+	1246: models.ConditionRain,
+	1249: models.ConditionSleet,
+	1252: models.ConditionSleet,
+	1255: models.ConditionSnow,
+	1258: models.ConditionSnow,
+	1261: models.ConditionSleet,
+	1264: models.ConditionSleet,
+	1273: models.ConditionThunderstorm,
+	1276: models.ConditionThunderstorm,
+	1279: models.ConditionThunderstorm,
+	1282: models.ConditionThunderstorm,
+}
+
+// wmoCode is a WMO weather interpretation code as Open-Meteo reports it.
+type wmoCode struct {
+	text      string
+	condition models.Condition
+}
+
+// wmoCodes maps WMO weather interpretation codes (WW), see
+// https://open-meteo.com/en/docs. Open-Meteo sends only the code, so the text
+// is ours and always English.
+var wmoCodes = map[int]wmoCode{
+	0:  {"Clear sky", models.ConditionClear},
+	1:  {"Mainly clear", models.ConditionPartlyCloudy},
+	2:  {"Partly cloudy", models.ConditionPartlyCloudy},
+	3:  {"Overcast", models.ConditionCloudy},
+	45: {"Fog", models.ConditionFog},
+	48: {"Depositing rime fog", models.ConditionFog},
+	51: {"Light drizzle", models.ConditionDrizzle},
+	53: {"Moderate drizzle", models.ConditionDrizzle},
+	55: {"Dense drizzle", models.ConditionDrizzle},
+	56: {"Light freezing drizzle", models.ConditionSleet},
+	57: {"Dense freezing drizzle", models.ConditionSleet},
+	61: {"Slight rain", models.ConditionRain},
+	63: {"Moderate rain", models.ConditionRain},
+	65: {"Heavy rain", models.ConditionRain},
+	66: {"Light freezing rain", models.ConditionSleet},
+	67: {"Heavy freezing rain", models.ConditionSleet},
+	71: {"Slight snow fall", models.ConditionSnow},
+	73: {"Moderate snow fall", models.ConditionSnow},
+	75: {"Heavy snow fall", models.ConditionSnow},
+	77: {"Snow grains", models.ConditionSnow},
+	80: {"Slight rain showers", models.ConditionRain},
+	81: {"Moderate rain showers", models.ConditionRain},
+	82: {"Violent rain showers", models.ConditionRain},
+	85: {"Slight snow showers", models.ConditionSnow},
+	86: {"Heavy snow showers", models.ConditionSnow},
+	95: {"Thunderstorm", models.ConditionThunderstorm},
+	96: {"Thunderstorm with slight hail", models.ConditionThunderstorm},
+	99: {"Thunderstorm with heavy hail", models.ConditionThunderstorm},
+}
+
+func conditionInRanges(ranges []codeRange, code int) models.Condition {
+	for _, r := range ranges {
+		if code >= r.from && code <= r.to {
+			return r.condition
+		}
+	}
+	return models.ConditionUnknown
+}
+
+func openWeatherCondition(id int) models.Condition {
+	return conditionInRanges(openWeatherConditions, id)
+}
+
+func weatherBitCondition(code int) models.Condition {
+	return conditionInRanges(weatherBitConditions, code)
+}
+
+func weatherAPICondition(code int) models.Condition {
+	if c, ok := weatherAPIConditions[code]; ok {
+		return c
+	}
+	return models.ConditionUnknown
+}
+
+// wmoCondition returns the text and canonical condition for a WMO code.
+func wmoCondition(code int) (string, models.Condition) {
+	if c, ok := wmoCodes[code]; ok {
+		return c.text, c.condition
+	}
+	return "Unknown", models.ConditionUnknown
+}
@@ -0,0 +1,79 @@
+package weather
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+func TestProviderConditions(t *testing.T) {
+	tests := []struct {
+		name  string
+		fn    func(int) models.Condition
+		code  int
+		wants models.Condition
+	}{
+		{"OpenWeatherThunderstorm", openWeatherCondition, 211, models.ConditionThunderstorm},
+		{"OpenWeatherFreezingRain", openWeatherCondition, 511, models.ConditionSleet},
+		{"OpenWeatherShowers", openWeatherCondition, 521, models.ConditionRain},
+		{"OpenWeatherMist", openWeatherCondition, 701, models.ConditionFog},
+		{"OpenWeatherFewClouds", openWeatherCondition, 801, models.ConditionPartlyCloudy},
+		{"OpenWeatherOvercast", openWeatherCondition, 804, models.ConditionCloudy},
+		{"OpenWeatherUnmapped", openWeatherCondition, 999, models.ConditionUnknown},
+		{"WeatherBitDrizzle", weatherBitCondition, 301, models.ConditionDrizzle},
+		{"WeatherBitMixSnowRain", weatherBitCondition, 610, models.ConditionSleet},
+		{"WeatherBitFlurries", weatherBitCondition, 623, models.ConditionSnow},
+		{"WeatherBitClear", weatherBitCondition, 800, models.ConditionClear},
+		{"WeatherBitUnknownPrecipitation", weatherBitCondition, 900, models.ConditionUnknown},
+		{"WeatherAPIPartlyCloudy", weatherAPICondition, 1003, models.ConditionPartlyCloudy},
+		{"WeatherAPIFreezingFog", weatherAPICondition, 1147, models.ConditionFog},
+		{"WeatherAPIIcePellets", weatherAPICondition, 1237, models.ConditionSleet},
+		{"WeatherAPISnowWithThunder", weatherAPICondition, 1282, models.ConditionThunderstorm},
+		{"WeatherAPIUnmapped", weatherAPICondition, 1001, models.ConditionUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wants, tt.fn(tt.code))
+		})
+	}
+}
+
+func TestWMOCondition(t *testing.T) {
+	tests := []struct {
+		code  int
+		text  string
+		wants models.Condition
+	}{
+		{0, "Clear sky", models.ConditionClear},
+		{2, "Partly cloudy", models.ConditionPartlyCloudy},
+		{48, "Depositing rime fog", models.ConditionFog},
+		{57, "Dense freezing drizzle", models.ConditionSleet},
+		{81, "Moderate rain showers", models.ConditionRain},
+		{86, "Heavy snow showers", models.ConditionSnow},
+		{99, "Thunderstorm with heavy hail", models.ConditionThunderstorm},
+		{4, "Unknown", models.ConditionUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			text, condition := wmoCondition(tt.code)
+			assert.Equal(t, tt.text, text)
+			assert.Equal(t, tt.wants, condition)
+		})
+	}
+}
+
+func TestMajority_VotesByCanonicalCondition(t *testing.T) {
+	readings := []models.WeatherData{
+		{Condition: "Light rain", ConditionCode: models.ConditionRain, Provider: "A"},
+		{Condition: "Clouds", ConditionCode: models.ConditionCloudy, Provider: "B"},
+		{Condition: "Overcast clouds", ConditionCode: models.ConditionCloudy, Provider: "C"},
+	}
+
+	got := majority(readings)
+	assert.Equal(t, "Clouds", got.Condition)
+	assert.Equal(t, models.ConditionCloudy, got.ConditionCode)
+}
@@ -0,0 +1,234 @@
+package decorators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+const (
+	kindWeather  = "weather"
+	kindForecast = "forecast"
+)
+
+// CacheEntries lists the cached weather and forecast entries whose keys match a
+// Redis glob pattern, such as "weather:*" or "*kyiv*". An empty pattern lists
+// every entry.
+func (s *CachedService) CacheEntries(ctx context.Context, pattern string) ([]models.CachedItem, error) {
+	if pattern == "" {
+		pattern = "*"
+	}
+
+	weather, err := listEntries(ctx, s, s.cache, kindWeather, weatherPrefix, pattern)
+	if err != nil {
+		return nil, err
+	}
+	forecasts, err := listEntries(ctx, s, s.forecastCache, kindForecast, forecastPrefix, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	items := append(weather, forecasts...)
+	slices.SortFunc(items, func(a, b models.CachedItem) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return items, nil
+}
+
+// CacheEntry describes a single entry, including its cached value.
+func (s *CachedService) CacheEntry(ctx context.Context, key string) (models.CachedItem, error) {
+	switch {
+	case strings.HasPrefix(key, weatherPrefix):
+		return describe(ctx, s, s.cache, kindWeather, key, true)
+	case strings.HasPrefix(key, forecastPrefix):
+		return describe(ctx, s, s.forecastCache, kindForecast, key, true)
+	default:
+		return models.CachedItem{}, fmt.Errorf("%w: %s", models.ErrNotCached, key)
+	}
+}
+
+// PurgeCity drops the cached current weather in every language and every
+// cached forecast of a city and returns the keys it removed.
+func (s *CachedService) PurgeCity(ctx context.Context, input string) ([]string, error) {
+	city := s.resolver.Resolve(input)
+	return s.purge(ctx, cityWeatherPatterns(city.ID), escapeGlob(forecastPrefix+city.ID+":")+"*")
+}
+
+// PurgePattern drops every cached entry whose key matches a Redis glob pattern
+// and returns the keys it removed.
+func (s *CachedService) PurgePattern(ctx context.Context, pattern string) ([]string, error) {
+	return s.purge(ctx, []string{pattern}, pattern)
+}
+
+// RefreshCity refetches the current weather of a city from the providers,
+// replacing the cached entry whatever its age, and drops the city's forecasts
+// and its weather in languages other than English so that they are refetched
+// on next use.
+func (s *CachedService) RefreshCity(ctx context.Context, input string) (models.CachedItem, error) {
+	city := s.resolver.Resolve(input)
+	key := weatherKey(city.ID, "")
+
+	if _, err := refresh(ctx, s, s.cache, key, s.cityFetcher(city, ""), s.cityPublisher(city, "")); err != nil {
+		return models.CachedItem{}, err
+	}
+	if _, err := purgeMatching(ctx, s.cache, weatherPrefix, escapeGlob(key+":")+"*"); err != nil {
+		s.logger.Warn().
+			Ctx(ctx).
+			Str("city", city.ID).
+			Err(err).
+			Msg("failed to drop translated weather of refreshed city")
+	}
+	if _, err := purgeMatching(ctx, s.forecastCache, forecastPrefix, escapeGlob(forecastPrefix+city.ID+":")+"*"); err != nil {
+		s.logger.Warn().
+			Ctx(ctx).
+			Str("city", city.ID).
+			Err(err).
+			Msg("failed to drop forecasts of refreshed city")
+	}
+
+	s.logger.Info().
+		Ctx(ctx).
+		Str("key", key).
+		Msg("cache entry refreshed by admin")
+	return s.CacheEntry(ctx, key)
+}
+
+func (s *CachedService) purge(ctx context.Context, weatherPatterns []string, forecastPattern string) ([]string, error) {
+	var weather []string
+	for _, pattern := range weatherPatterns {
+		keys, err := purgeMatching(ctx, s.cache, weatherPrefix, pattern)
+		if err != nil {
+			return weather, err
+		}
+		weather = append(weather, keys...)
+	}
+	forecasts, err := purgeMatching(ctx, s.forecastCache, forecastPrefix, forecastPattern)
+	if err != nil {
+		return weather, err
+	}
+
+	purged := append(weather, forecasts...)
+	s.logger.Info().
+		Ctx(ctx).
+		Strs("keys", purged).
+		Msg("cache entries purged by admin")
+	return purged, nil
+}
+
+// matchingKeys returns the keys of c that match pattern and carry prefix. The
+// weather and forecast caches share one Redis keyspace, so the prefix tells
+// whose keys are whose.
+func matchingKeys[T any](ctx context.Context, c cacheClient[T], prefix, pattern string) ([]string, error) {
+	keys, err := c.Keys(ctx, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("list cache keys: %w", err)
+	}
+	return slices.DeleteFunc(keys, func(key string) bool {
+		return !strings.HasPrefix(key, prefix)
+	}), nil
+}
+
+func purgeMatching[T any](ctx context.Context, c cacheClient[T], prefix, pattern string) ([]string, error) {
+	keys, err := matchingKeys(ctx, c, prefix, pattern)
+	if err != nil || len(keys) == 0 {
+		return nil, err
+	}
+	if err := c.Delete(ctx, keys...); err != nil {
+		return nil, fmt.Errorf("delete cache keys: %w", err)
+	}
+	return keys, nil
+}
+
+func listEntries[T any](
+	ctx context.Context,
+	s *CachedService,
+	c cacheClient[models.CacheEntry[T]],
+	kind, prefix, pattern string,
+) ([]models.CachedItem, error) {
+	keys, err := matchingKeys(ctx, c, prefix, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.CachedItem, 0, len(keys))
+	for _, key := range keys {
+		item, err := describe(ctx, s, c, kind, key, false)
+		if err != nil {
+			// Expired or purged since it was listed.
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func describe[T any](
+	ctx context.Context,
+	s *CachedService,
+	c cacheClient[models.CacheEntry[T]],
+	kind, key string,
+	withValue bool,
+) (models.CachedItem, error) {
+	entry, err := c.Get(ctx, key)
+	if err != nil {
+		return models.CachedItem{}, fmt.Errorf("%w: %s: %w", models.ErrNotCached, key, err)
+	}
+	ttl, err := c.TTL(ctx, key)
+	if err != nil {
+		return models.CachedItem{}, fmt.Errorf("%w: %s: %w", models.ErrNotCached, key, err)
+	}
+
+	age := time.Since(entry.StoredAt)
+	item := models.CachedItem{
+		Key:      key,
+		Kind:     kind,
+		City:     cityOf(key),
+		StoredAt: entry.StoredAt,
+		Age:      age,
+		TTL:      ttl,
+		State:    s.ttl.state(age),
+	}
+	if withValue {
+		item.Value = entry.Value
+	}
+	return item, nil
+}
+
+// cityWeatherPatterns match the current weather of a city in every language.
+func cityWeatherPatterns(cityID string) []string {
+	key := escapeGlob(weatherKey(cityID, ""))
+	return []string{key, key + ":*"}
+}
+
+// cityOf extracts the city ID from a cache key; coordinate keys have none.
+func cityOf(key string) string {
+	switch {
+	case strings.HasPrefix(key, geoPrefix):
+		return ""
+	case strings.HasPrefix(key, weatherPrefix):
+		id, _, _ := strings.Cut(strings.TrimPrefix(key, weatherPrefix), ":")
+		return id
+	case strings.HasPrefix(key, forecastPrefix):
+		id, _, _ := strings.Cut(strings.TrimPrefix(key, forecastPrefix), ":")
+		return id
+	default:
+		return ""
+	}
+}
+
+// escapeGlob quotes the characters Redis globs treat specially, since IDs of
+// cities missing from the catalog come from user input.
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`*?[]\`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,134 @@
+//go:build unit
+
+package decorators_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/watch"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather/decorators"
+)
+
+func TestCachedService_Admin(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	weatherCache := newMemoryCache[models.CacheEntry[models.WeatherData]]()
+	forecastCache := newMemoryCache[models.CacheEntry[models.Forecast]]()
+	seed := func() {
+		weatherCache.items = map[string]models.CacheEntry[models.WeatherData]{
+			"weather:kyiv-ua":    {Value: models.WeatherData{City: "Kyiv", Temperature: -99}, StoredAt: now.Add(-2 * time.Hour)},
+			"weather:lviv-ua":    {Value: models.WeatherData{City: "Lviv"}, StoredAt: now},
+			"weather:geo:u8vxn":  {Value: models.WeatherData{City: "Kyiv"}, StoredAt: now.Add(-5 * time.Minute)},
+			"forecast:kyiv-ua:3": {},
+		}
+		forecastCache.items = map[string]models.CacheEntry[models.Forecast]{
+			"forecast:kyiv-ua:3": {Value: models.Forecast{City: "Kyiv"}, StoredAt: now},
+			"forecast:kyiv-ua:5": {Value: models.Forecast{City: "Kyiv"}, StoredAt: now},
+			"forecast:lviv-ua:3": {Value: models.Forecast{City: "Lviv"}, StoredAt: now},
+			"weather:lviv-ua":    {},
+		}
+	}
+
+	l, err := logger.NewLogger("", "cached_service_admin")
+	require.NoError(t, err)
+
+	newService := func(inner *mockInner) *decorators.CachedService {
+		return decorators.NewCachedService(inner, newResolver(t), weatherCache, forecastCache,
+			watch.NewHub(), testTTL, l)
+	}
+
+	t.Run("ListsEntriesOfBothCaches", func(t *testing.T) {
+		seed()
+		items, err := newService(&mockInner{}).CacheEntries(ctx, "")
+		require.NoError(t, err)
+
+		// The caches share a keyspace in Redis; each only reports its own keys.
+		var keys []string
+		for _, item := range items {
+			keys = append(keys, item.Key)
+		}
+		assert.Equal(t, []string{
+			"forecast:kyiv-ua:3", "forecast:kyiv-ua:5", "forecast:lviv-ua:3",
+			"weather:geo:u8vxn", "weather:kyiv-ua", "weather:lviv-ua",
+		}, keys)
+
+		kyiv := items[4]
+		assert.Equal(t, "weather", kyiv.Kind)
+		assert.Equal(t, "kyiv-ua", kyiv.City)
+		assert.Equal(t, models.CacheStateExpired, kyiv.State)
+		assert.Nil(t, kyiv.Value)
+		assert.Equal(t, models.CacheStateStale, items[3].State)
+		assert.Empty(t, items[3].City)
+		assert.Equal(t, "kyiv-ua", items[0].City)
+		assert.Equal(t, models.CacheStateFresh, items[0].State)
+	})
+
+	t.Run("InspectsEntry", func(t *testing.T) {
+		seed()
+		svc := newService(&mockInner{})
+
+		item, err := svc.CacheEntry(ctx, "weather:kyiv-ua")
+		require.NoError(t, err)
+		assert.Equal(t, models.WeatherData{City: "Kyiv", Temperature: -99}, item.Value)
+
+		_, err = svc.CacheEntry(ctx, "weather:atlantis")
+		assert.ErrorIs(t, err, models.ErrNotCached)
+		_, err = svc.CacheEntry(ctx, "subscriptions:1")
+		assert.ErrorIs(t, err, models.ErrNotCached)
+	})
+
+	t.Run("PurgesCity", func(t *testing.T) {
+		seed()
+		keys, err := newService(&mockInner{}).PurgeCity(ctx, "Київ")
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{"weather:kyiv-ua", "forecast:kyiv-ua:3", "forecast:kyiv-ua:5"}, keys)
+		assert.Len(t, weatherCache.items, 3)
+		assert.Len(t, forecastCache.items, 2)
+	})
+
+	t.Run("PurgesPattern", func(t *testing.T) {
+		seed()
+		keys, err := newService(&mockInner{}).PurgePattern(ctx, "*lviv*")
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{"weather:lviv-ua", "forecast:lviv-ua:3"}, keys)
+	})
+
+	t.Run("RefreshesCity", func(t *testing.T) {
+		seed()
+		inner := &mockInner{}
+		inner.On("GetByCity", mock.Anything, "Kyiv", "").Return(models.WeatherData{City: "Kyiv", Temperature: 18}, nil).Once()
+		t.Cleanup(func() { inner.AssertExpectations(t) })
+
+		item, err := newService(inner).RefreshCity(ctx, "kiev")
+		require.NoError(t, err)
+
+		assert.Equal(t, models.CacheStateFresh, item.State)
+		assert.Equal(t, 18.0, item.Value.(models.WeatherData).Temperature)
+		assert.NotContains(t, forecastCache.items, "forecast:kyiv-ua:3")
+		assert.Contains(t, forecastCache.items, "forecast:lviv-ua:3")
+	})
+
+	t.Run("RefreshFailureKeepsEntry", func(t *testing.T) {
+		seed()
+		inner := &mockInner{}
+		inner.On("GetByCity", mock.Anything, "Kyiv", "").Return(models.WeatherData{}, errors.New("providers down")).Once()
+
+		_, err := newService(inner).RefreshCity(ctx, "kyiv")
+		require.Error(t, err)
+
+		assert.Equal(t, -99.0, weatherCache.items["weather:kyiv-ua"].Value.Temperature)
+		assert.Contains(t, forecastCache.items, "forecast:kyiv-ua:3")
+	})
+}
@@ -0,0 +1,54 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/rs/zerolog"
+)
+
+type observationRecorder interface {
+	Record(ctx context.Context, cityID string, data models.WeatherData) error
+}
+
+// RecordingService keeps every current weather successfully fetched for a city
+// as an observation, so that history outlives the cache. It sits below the
+// cache, where only real provider fetches pass. Coordinate lookups are not
+// recorded, as they have no canonical city.
+type RecordingService struct {
+	weatherGetterService
+	resolver cityResolver
+	recorder observationRecorder
+	logger   zerolog.Logger
+}
+
+func NewRecordingService(
+	inner weatherGetterService,
+	resolver cityResolver,
+	recorder observationRecorder,
+	logger zerolog.Logger,
+) *RecordingService {
+	return &RecordingService{
+		weatherGetterService: inner,
+		resolver:             resolver,
+		recorder:             recorder,
+		logger:               logger,
+	}
+}
+
+func (s *RecordingService) GetByCity(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	weather, err := s.weatherGetterService.GetByCity(ctx, city, lang)
+	if err != nil {
+		return weather, err
+	}
+
+	cityID := s.resolver.Resolve(city).ID
+	if err := s.recorder.Record(ctx, cityID, weather); err != nil {
+		s.logger.Warn().
+			Ctx(ctx).
+			Str("city", cityID).
+			Err(err).
+			Msg("failed to record observation")
+	}
+	return weather, nil
+}
@@ -0,0 +1,61 @@
+//go:build unit
+
+package decorators_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather/decorators"
+)
+
+type mockRecorder struct {
+	mock.Mock
+}
+
+func (m *mockRecorder) Record(ctx context.Context, cityID string, data models.WeatherData) error {
+	return m.Called(ctx, cityID, data).Error(0)
+}
+
+func TestRecordingService(t *testing.T) {
+	ctx := context.Background()
+	kyiv := models.WeatherData{City: "Kyiv", Temperature: 18, Provider: "WeatherAPI"}
+
+	l, err := logger.NewLogger("", "recording_service")
+	require.NoError(t, err)
+
+	t.Run("RecordsFetches", func(t *testing.T) {
+		inner := &mockInner{}
+		inner.On("GetByCity", mock.Anything, "Kyiv", "").Return(kyiv, nil).Once()
+		recorder := &mockRecorder{}
+		recorder.On("Record", mock.Anything, "kyiv-ua", kyiv).Return(errors.New("disk full")).Once()
+		t.Cleanup(func() {
+			inner.AssertExpectations(t)
+			recorder.AssertExpectations(t)
+		})
+
+		got, err := decorators.NewRecordingService(inner, newResolver(t), recorder, l).GetByCity(ctx, "Kyiv", "")
+
+		// Failing to record never fails the lookup.
+		require.NoError(t, err)
+		assert.Equal(t, kyiv, got)
+	})
+
+	t.Run("SkipsFailures", func(t *testing.T) {
+		inner := &mockInner{}
+		inner.On("GetByCity", mock.Anything, "Kyiv", "").Return(models.WeatherData{}, errors.New("providers down")).Once()
+		recorder := &mockRecorder{}
+
+		_, err := decorators.NewRecordingService(inner, newResolver(t), recorder, l).GetByCity(ctx, "Kyiv", "")
+
+		require.Error(t, err)
+		recorder.AssertNotCalled(t, "Record", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
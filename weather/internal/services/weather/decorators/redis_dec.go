@@ -3,75 +3,245 @@ package decorators
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/geo"
 	"github.com/rs/zerolog"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// batchConcurrency caps how many cache misses GetByCities fetches at once.
+	batchConcurrency = 8
+
+	// geohashPrecision sizes the cells coordinate lookups are cached by; five
+	// characters make cells of about 5 x 5 km.
+	geohashPrecision = 5
+	// nearestCityKm is how far a catalog city may be from a cell and still name it.
+	nearestCityKm = 25
+
+	// Cache key prefixes: weather:<city ID>, weather:<city ID>:<lang> for
+	// condition text in languages other than English, weather:geo:<geohash> and
+	// forecast:<city ID>:<days>. Units are not part of keys; everything is
+	// cached in metric.
+	weatherPrefix  = "weather:"
+	geoPrefix      = "weather:geo:"
+	forecastPrefix = "forecast:"
 )
 
 type weatherGetterService interface {
-	GetByCity(ctx context.Context, city string) (models.WeatherData, error)
+	GetByCity(ctx context.Context, city, lang string) (models.WeatherData, error)
+	GetByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error)
+	GetForecast(ctx context.Context, city string, days int) (models.Forecast, error)
 }
 
 type cacheClient[T any] interface {
 	Set(ctx context.Context, key string, value T) error
 	Get(ctx context.Context, key string) (T, error)
+	Keys(ctx context.Context, pattern string) ([]string, error)
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// cityResolver maps user input and coordinates onto canonical cities.
+type cityResolver interface {
+	Resolve(input string) models.City
+	Nearest(lat, lon float64) (models.City, float64)
+}
+
+// publisher is notified every time fresh weather for a city lands in the cache,
+// keyed by the canonical city ID.
+type publisher interface {
+	Publish(cityID string, data models.WeatherData)
 }
 
 type CachedService struct {
-	inner  weatherGetterService
-	cache  cacheClient[models.WeatherData]
-	logger zerolog.Logger
+	inner         weatherGetterService
+	resolver      cityResolver
+	cache         cacheClient[models.CacheEntry[models.WeatherData]]
+	forecastCache cacheClient[models.CacheEntry[models.Forecast]]
+	updates       publisher
+	ttl           CacheTTL
+	logger        zerolog.Logger
+
+	flight     singleflight.Group
+	mu         sync.Mutex
+	refreshing map[string]struct{}
 }
 
 func NewCachedService(
 	inner weatherGetterService,
-	cache cacheClient[models.WeatherData],
+	resolver cityResolver,
+	cache cacheClient[models.CacheEntry[models.WeatherData]],
+	forecastCache cacheClient[models.CacheEntry[models.Forecast]],
+	updates publisher,
+	ttl CacheTTL,
 	logger zerolog.Logger,
 ) *CachedService {
-	return &CachedService{inner: inner, cache: cache, logger: logger}
+	return &CachedService{
+		inner:         inner,
+		resolver:      resolver,
+		cache:         cache,
+		forecastCache: forecastCache,
+		updates:       updates,
+		ttl:           ttl,
+		logger:        logger,
+		refreshing:    make(map[string]struct{}),
+	}
 }
 
-func (s *CachedService) GetByCity(ctx context.Context, city string) (models.WeatherData, error) {
-	key := fmt.Sprintf("weather:%s", city)
-	var weather models.WeatherData
-
-	// Try cache
-	weather, err := s.cache.Get(ctx, key)
-	if err == nil {
-		s.logger.Info().
-			Ctx(ctx).
-			Str("city", city).
-			Str("key", key).
-			Msg("cache hit")
-		return weather, nil
-	}
-	s.logger.Info().
-		Ctx(ctx).
-		Str("city", city).
-		Str("key", key).
-		Err(err).
-		Msg("cache miss")
+// GetByCity returns the current weather in a city, with the condition described
+// in lang, a code normalized by models.NormalizeLang.
+func (s *CachedService) GetByCity(ctx context.Context, input, lang string) (models.WeatherData, error) {
+	city := s.resolver.Resolve(input)
 
-	// Fallback to inner service
-	weather, err = s.inner.GetByCity(ctx, city)
+	weather, stale, err := lookup(ctx, s, s.cache, weatherKey(city.ID, lang),
+		s.cityFetcher(city, lang), s.cityPublisher(city, lang))
 	if err != nil {
-		s.logger.Error().
-			Ctx(ctx).
-			Str("city", city).
-			Err(err).
-			Msg("inner service failed")
 		return models.WeatherData{}, err
 	}
+	weather.Stale = stale
 
-	// Populate cache
-	if err := s.cache.Set(ctx, key, weather); err != nil {
-		s.logger.Error().
-			Ctx(ctx).
-			Str("city", city).
-			Str("key", key).
-			Err(err).
-			Msg("cache set failed")
+	return weather, nil
+}
+
+// GetByCoordinates caches by geohash cell, so nearby points share one entry
+// fetched for the cell's center. The city is the nearest catalog city when one is
+// close enough, otherwise the one the provider places the center in.
+func (s *CachedService) GetByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	cell := geo.Encode(lat, lon, geohashPrecision)
+
+	weather, stale, err := lookup(ctx, s, s.cache, geoPrefix+cell,
+		func(ctx context.Context) (models.WeatherData, error) {
+			centerLat, centerLon := geo.Center(cell)
+			weather, err := s.inner.GetByCoordinates(ctx, centerLat, centerLon)
+			if city, km := s.resolver.Nearest(centerLat, centerLon); km <= nearestCityKm {
+				weather.City = city.Name
+			}
+			return weather, err
+		},
+		func(models.WeatherData) {},
+	)
+	if err != nil {
+		return models.WeatherData{}, err
 	}
+	weather.Stale = stale
 
 	return weather, nil
 }
+
+func (s *CachedService) GetForecast(ctx context.Context, input string, days int) (models.Forecast, error) {
+	city := s.resolver.Resolve(input)
+	key := fmt.Sprintf("%s%s:%d", forecastPrefix, city.ID, days)
+
+	forecast, stale, err := lookup(ctx, s, s.forecastCache, key,
+		func(ctx context.Context) (models.Forecast, error) {
+			forecast, err := s.inner.GetForecast(ctx, city.Name, days)
+			forecast.City = canonicalName(city, forecast.City)
+			return forecast, err
+		},
+		func(models.Forecast) {},
+	)
+	if err != nil {
+		return models.Forecast{}, err
+	}
+	forecast.Stale = stale
+
+	return forecast, nil
+}
+
+func weatherKey(cityID, lang string) string {
+	if lang == "" {
+		return weatherPrefix + cityID
+	}
+	return weatherPrefix + cityID + ":" + lang
+}
+
+// cityFetcher fetches the current weather of a resolved city.
+func (s *CachedService) cityFetcher(city models.City, lang string) func(ctx context.Context) (models.WeatherData, error) {
+	return func(ctx context.Context) (models.WeatherData, error) {
+		weather, err := s.inner.GetByCity(ctx, city.Name, lang)
+		weather.City = canonicalName(city, weather.City)
+		return weather, err
+	}
+}
+
+// cityPublisher tells watchers of a city about freshly fetched weather. Watchers
+// get English condition text, so fetches in other languages are not published.
+func (s *CachedService) cityPublisher(city models.City, lang string) func(models.WeatherData) {
+	return func(weather models.WeatherData) {
+		if lang == "" {
+			s.updates.Publish(city.ID, weather)
+		}
+	}
+}
+
+// canonicalName is the name responses report: the dataset's for known cities,
+// otherwise the provider's, which is usually better spelled than the input.
+func canonicalName(city models.City, reported string) string {
+	if city.Country == "" && reported != "" {
+		return reported
+	}
+	return city.Name
+}
+
+// GetByCities looks up each distinct city once, serving hits from the cache and
+// fetching misses concurrently. Results are keyed by the cities exactly as
+// requested, so callers can look up their own, unnormalized input; spellings
+// that differ only in surrounding space share one lookup. Per-city failures are
+// reported in the result rather than failing the whole batch.
+func (s *CachedService) GetByCities(ctx context.Context, cities []string, lang string) models.BatchWeather {
+	result := models.BatchWeather{
+		Weather: make(map[string]models.WeatherData, len(cities)),
+		Errors:  make(map[string]error),
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, batchConcurrency)
+	)
+	requested := make(map[string][]string, len(cities)) // trimmed city -> inputs spelling it
+	for _, input := range cities {
+		city := strings.TrimSpace(input)
+		if city == "" || slices.Contains(requested[city], input) {
+			continue
+		}
+		requested[city] = append(requested[city], input)
+	}
+
+	for city, inputs := range requested {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			weather, err := s.GetByCity(ctx, city, lang)
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, input := range inputs {
+				if err != nil {
+					result.Errors[input] = err
+					continue
+				}
+				result.Weather[input] = weather
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.logger.Info().
+		Ctx(ctx).
+		Int("requested", len(cities)).
+		Int("distinct", len(requested)).
+		Int("failed", len(result.Errors)).
+		Msg("batch lookup completed")
+
+	return result
+}
@@ -0,0 +1,409 @@
+//go:build unit
+
+package decorators_test
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/cities"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/watch"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather/decorators"
+)
+
+type mockInner struct {
+	mock.Mock
+}
+
+func (m *mockInner) GetByCity(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	args := m.Called(ctx, city, lang)
+	data, ok := args.Get(0).(models.WeatherData)
+	if !ok {
+		return models.WeatherData{}, args.Error(1)
+	}
+	return data, args.Error(1)
+}
+
+func (m *mockInner) GetByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	args := m.Called(ctx, lat, lon)
+	data, ok := args.Get(0).(models.WeatherData)
+	if !ok {
+		return models.WeatherData{}, args.Error(1)
+	}
+	return data, args.Error(1)
+}
+
+func (m *mockInner) GetForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	args := m.Called(ctx, city, days)
+	data, ok := args.Get(0).(models.Forecast)
+	if !ok {
+		return models.Forecast{}, args.Error(1)
+	}
+	return data, args.Error(1)
+}
+
+type memoryCache[T any] struct {
+	mu    sync.Mutex
+	items map[string]T
+}
+
+func newMemoryCache[T any]() *memoryCache[T] {
+	return &memoryCache[T]{items: map[string]T{}}
+}
+
+func (c *memoryCache[T]) Set(_ context.Context, key string, value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = value
+	return nil
+}
+
+//nolint:ireturn
+func (c *memoryCache[T]) Get(_ context.Context, key string) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.items[key]
+	if !ok {
+		var zero T
+		return zero, errors.New("miss")
+	}
+	return value, nil
+}
+
+func (c *memoryCache[T]) Keys(_ context.Context, pattern string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var keys []string
+	for key := range c.items {
+		if ok, _ := path.Match(pattern, key); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (c *memoryCache[T]) TTL(_ context.Context, key string) (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.items[key]; !ok {
+		return 0, errors.New("miss")
+	}
+	return time.Hour, nil
+}
+
+func (c *memoryCache[T]) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.items, key)
+	}
+	return nil
+}
+
+var testTTL = decorators.CacheTTL{Soft: time.Minute, Hard: time.Hour}
+
+func newResolver(t *testing.T) *cities.Resolver {
+	t.Helper()
+	r, err := cities.NewResolver()
+	require.NoError(t, err)
+	return r
+}
+
+func TestCachedService_GetByCities(t *testing.T) {
+	ctx := context.Background()
+	kyiv := models.WeatherData{City: "Kyiv", Temperature: 18}
+	lviv := models.WeatherData{City: "Lviv", Temperature: 15}
+	notFound := errors.New("city not found")
+
+	cache := newMemoryCache[models.CacheEntry[models.WeatherData]]()
+	require.NoError(t, cache.Set(ctx, "weather:kyiv-ua", models.CacheEntry[models.WeatherData]{
+		Value:    kyiv,
+		StoredAt: time.Now(),
+	}))
+
+	inner := &mockInner{}
+	inner.On("GetByCity", mock.Anything, "Lviv", "").Return(lviv, nil).Once()
+	inner.On("GetByCity", mock.Anything, "Atlantis", "").Return(models.WeatherData{}, notFound).Once()
+
+	t.Cleanup(func() {
+		inner.AssertExpectations(t)
+		inner.AssertNotCalled(t, "GetByCity", mock.Anything, "Kyiv", "")
+	})
+
+	l, err := logger.NewLogger("", "cached_service_batch")
+	require.NoError(t, err)
+
+	svc := decorators.NewCachedService(inner, newResolver(t), cache, newMemoryCache[models.CacheEntry[models.Forecast]](),
+		watch.NewHub(), testTTL, l)
+
+	batch := svc.GetByCities(ctx, []string{"Kyiv", "Lviv", "Kyiv", " Lviv ", "Atlantis", ""}, "")
+
+	// Padded input is reported as requested but shares the lookup of its trimmed spelling.
+	assert.Equal(t, map[string]models.WeatherData{"Kyiv": kyiv, "Lviv": lviv, " Lviv ": lviv}, batch.Weather)
+	require.Len(t, batch.Errors, 1)
+	assert.ErrorIs(t, batch.Errors["Atlantis"], notFound)
+}
+
+func TestCachedService_Staleness(t *testing.T) {
+	ctx := context.Background()
+	cached := models.WeatherData{City: "Kyiv", Temperature: 18}
+	fresh := models.WeatherData{City: "Kyiv", Temperature: 21}
+	unavailable := models.NewProviderError("WeatherAPI", models.ErrProviderUnavailable, errors.New("status 503"))
+
+	tests := []struct {
+		name      string
+		age       time.Duration
+		fetchErr  error
+		want      models.WeatherData
+		wantErr   error
+		wantStale bool
+	}{
+		{name: "FreshHit", age: time.Second, want: cached},
+		{name: "StaleWhileRevalidate", age: 10 * time.Minute, want: cached, wantStale: true},
+		{name: "ExpiredRefetched", age: 2 * time.Hour, want: fresh},
+		{name: "ExpiredServedOnError", age: 2 * time.Hour, fetchErr: unavailable, want: cached, wantStale: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newMemoryCache[models.CacheEntry[models.WeatherData]]()
+			require.NoError(t, cache.Set(ctx, "weather:kyiv-ua", models.CacheEntry[models.WeatherData]{
+				Value:    cached,
+				StoredAt: time.Now().Add(-tt.age),
+			}))
+
+			fetched := make(chan struct{})
+			inner := &mockInner{}
+			inner.On("GetByCity", mock.Anything, "Kyiv", "").
+				Return(fresh, tt.fetchErr).
+				Run(func(mock.Arguments) { close(fetched) }).
+				Maybe()
+
+			l, err := logger.NewLogger("", "cached_service_staleness")
+			require.NoError(t, err)
+
+			hub := watch.NewHub()
+			updates, stop := hub.Subscribe("kyiv-ua")
+			defer stop()
+
+			svc := decorators.NewCachedService(inner, newResolver(t), cache, newMemoryCache[models.CacheEntry[models.Forecast]](),
+				hub, testTTL, l)
+
+			got, err := svc.GetByCity(ctx, "Kyiv", "")
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want.Temperature, got.Temperature)
+			assert.Equal(t, tt.wantStale, got.Stale)
+
+			if tt.age < testTTL.Soft {
+				inner.AssertNotCalled(t, "GetByCity", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			// Every other case refetches, in the background for stale-while-revalidate.
+			select {
+			case <-fetched:
+			case <-time.After(time.Second):
+				t.Fatal("cache entry was not refreshed")
+			}
+			if tt.fetchErr == nil {
+				assert.Equal(t, fresh, <-updates)
+			}
+		})
+	}
+}
+
+func TestCachedService_CoalescesConcurrentMisses(t *testing.T) {
+	ctx := context.Background()
+	kyiv := models.WeatherData{City: "Kyiv", Temperature: 18}
+
+	release := make(chan time.Time)
+	inner := &mockInner{}
+	inner.On("GetByCity", mock.Anything, "Kyiv", "").
+		Return(kyiv, nil).
+		WaitUntil(release).
+		Once()
+
+	l, err := logger.NewLogger("", "cached_service_singleflight")
+	require.NoError(t, err)
+
+	svc := decorators.NewCachedService(inner, newResolver(t), newMemoryCache[models.CacheEntry[models.WeatherData]](),
+		newMemoryCache[models.CacheEntry[models.Forecast]](), watch.NewHub(), testTTL, l)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make(chan models.WeatherData, callers)
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := svc.GetByCity(ctx, "Kyiv", "")
+			assert.NoError(t, err)
+			results <- data
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	for data := range results {
+		assert.Equal(t, kyiv, data)
+	}
+	inner.AssertNumberOfCalls(t, "GetByCity", 1)
+}
+
+func TestCachedService_CallerLeavingDoesNotFailCoalescedFetch(t *testing.T) {
+	kyiv := models.WeatherData{City: "Kyiv", Temperature: 18}
+
+	release := make(chan time.Time)
+	inner := &mockInner{}
+	inner.On("GetByCity", mock.Anything, "Kyiv", "").
+		Return(kyiv, nil).
+		WaitUntil(release).
+		Run(func(args mock.Arguments) {
+			ctx, _ := args.Get(0).(context.Context)
+			assert.NoError(t, ctx.Err(), "the shared fetch outlives the caller that started it")
+		}).
+		Once()
+
+	l, err := logger.NewLogger("", "cached_service_singleflight_cancel")
+	require.NoError(t, err)
+
+	svc := decorators.NewCachedService(inner, newResolver(t), newMemoryCache[models.CacheEntry[models.WeatherData]](),
+		newMemoryCache[models.CacheEntry[models.Forecast]](), watch.NewHub(), testTTL, l)
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := svc.GetByCity(leaderCtx, "Kyiv", "")
+		leaderErr <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	followerData := make(chan models.WeatherData, 1)
+	go func() {
+		data, err := svc.GetByCity(context.Background(), "Kyiv", "")
+		assert.NoError(t, err)
+		followerData <- data
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	close(release)
+	assert.Equal(t, kyiv, <-followerData)
+	inner.AssertNumberOfCalls(t, "GetByCity", 1)
+}
+
+func TestCachedService_ResolvesCityNames(t *testing.T) {
+	ctx := context.Background()
+
+	inner := &mockInner{}
+	inner.On("GetByCity", mock.Anything, "Kyiv", "").
+		Return(models.WeatherData{City: "Kiev", Temperature: 18}, nil).
+		Once()
+
+	l, err := logger.NewLogger("", "cached_service_resolve")
+	require.NoError(t, err)
+
+	cache := newMemoryCache[models.CacheEntry[models.WeatherData]]()
+	svc := decorators.NewCachedService(inner, newResolver(t), cache,
+		newMemoryCache[models.CacheEntry[models.Forecast]](), watch.NewHub(), testTTL, l)
+
+	for _, input := range []string{"kyiv", "Kyiv ", "Kiev", "Київ"} {
+		data, err := svc.GetByCity(ctx, input, "")
+		require.NoError(t, err)
+		assert.Equal(t, "Kyiv", data.City, input)
+	}
+
+	inner.AssertExpectations(t)
+	_, err = cache.Get(ctx, "weather:kyiv-ua")
+	assert.NoError(t, err)
+}
+
+func TestCachedService_CachesPerLanguage(t *testing.T) {
+	ctx := context.Background()
+
+	inner := &mockInner{}
+	inner.On("GetByCity", mock.Anything, "Kyiv", "").
+		Return(models.WeatherData{City: "Kyiv", Condition: "Sunny"}, nil).
+		Once()
+	inner.On("GetByCity", mock.Anything, "Kyiv", "uk").
+		Return(models.WeatherData{City: "Kyiv", Condition: "Сонячно"}, nil).
+		Once()
+
+	l, err := logger.NewLogger("", "cached_service_lang")
+	require.NoError(t, err)
+
+	cache := newMemoryCache[models.CacheEntry[models.WeatherData]]()
+	hub := watch.NewHub()
+	updates, stop := hub.Subscribe("kyiv-ua")
+	defer stop()
+
+	svc := decorators.NewCachedService(inner, newResolver(t), cache,
+		newMemoryCache[models.CacheEntry[models.Forecast]](), hub, testTTL, l)
+
+	for range 2 {
+		data, err := svc.GetByCity(ctx, "Kyiv", "uk")
+		require.NoError(t, err)
+		assert.Equal(t, "Сонячно", data.Condition)
+
+		data, err = svc.GetByCity(ctx, "Kyiv", "")
+		require.NoError(t, err)
+		assert.Equal(t, "Sunny", data.Condition)
+	}
+
+	inner.AssertExpectations(t)
+	keys, err := cache.Keys(ctx, "weather:*")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"weather:kyiv-ua", "weather:kyiv-ua:uk"}, keys)
+
+	// Watchers only ever receive the English condition.
+	assert.Equal(t, "Sunny", (<-updates).Condition)
+	assert.Empty(t, updates)
+}
+
+func TestCachedService_GetByCoordinates(t *testing.T) {
+	ctx := context.Background()
+
+	inner := &mockInner{}
+	// Both Lviv points fall in one cell and are fetched once, for its center.
+	inner.On("GetByCoordinates", mock.Anything, mock.MatchedBy(func(lat float64) bool {
+		return lat > 49.8 && lat < 49.9
+	}), mock.Anything).Return(models.WeatherData{City: "Lvov", Temperature: 21}, nil).Once()
+	// Far from every catalog city the provider's name is kept.
+	inner.On("GetByCoordinates", mock.Anything, mock.MatchedBy(func(lat float64) bool {
+		return lat < 0
+	}), mock.Anything).Return(models.WeatherData{City: "Ocean", Temperature: 25}, nil).Once()
+
+	l, err := logger.NewLogger("", "cached_service_coordinates")
+	require.NoError(t, err)
+
+	svc := decorators.NewCachedService(inner, newResolver(t), newMemoryCache[models.CacheEntry[models.WeatherData]](),
+		newMemoryCache[models.CacheEntry[models.Forecast]](), watch.NewHub(), testTTL, l)
+
+	for _, point := range [][2]float64{{49.8397, 24.0297}, {49.8410, 24.0310}} {
+		data, err := svc.GetByCoordinates(ctx, point[0], point[1])
+		require.NoError(t, err)
+		assert.Equal(t, "Lviv", data.City)
+		assert.Equal(t, 21.0, data.Temperature)
+	}
+
+	data, err := svc.GetByCoordinates(ctx, -30, -20)
+	require.NoError(t, err)
+	assert.Equal(t, "Ocean", data.City)
+
+	inner.AssertExpectations(t)
+}
@@ -0,0 +1,42 @@
+package decorators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// Seed stores the given weather and forecast of a city as freshly fetched,
+// without asking any provider. The forecast is stored for every number of days
+// up to the days it holds, so any forecast lookup it covers is a hit.
+func (s *CachedService) Seed(ctx context.Context, input string, weather models.WeatherData, forecast models.Forecast) error {
+	city := s.resolver.Resolve(input)
+	now := time.Now().UTC()
+
+	weather.City = canonicalName(city, weather.City)
+	key := weatherKey(city.ID, "")
+	if err := s.cache.Set(ctx, key, models.CacheEntry[models.WeatherData]{Value: weather, StoredAt: now}); err != nil {
+		return fmt.Errorf("seed %s: %w", key, err)
+	}
+
+	forecast.City = canonicalName(city, forecast.City)
+	for days := 1; days <= len(forecast.Days); days++ {
+		key := fmt.Sprintf("%s%s:%d", forecastPrefix, city.ID, days)
+		entry := models.CacheEntry[models.Forecast]{
+			Value:    models.Forecast{City: forecast.City, Days: forecast.Days[:days]},
+			StoredAt: now,
+		}
+		if err := s.forecastCache.Set(ctx, key, entry); err != nil {
+			return fmt.Errorf("seed %s: %w", key, err)
+		}
+	}
+
+	s.logger.Debug().
+		Ctx(ctx).
+		Str("city", city.ID).
+		Int("forecast_days", len(forecast.Days)).
+		Msg("cache entry seeded")
+	return nil
+}
@@ -0,0 +1,179 @@
+package decorators
+
+import (
+	"context"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// refreshTimeout bounds a shared fetch, which may outlive the request that started it.
+const refreshTimeout = 30 * time.Second
+
+// CacheTTL splits the life of a cache entry in three. Younger than Soft it is
+// served as is. Between Soft and Hard it is served as stale while a background
+// refresh runs. Past Hard it is refetched first, and served as stale only if
+// every provider fails. The cache's own expiration decides when it is gone.
+type CacheTTL struct {
+	Soft time.Duration
+	Hard time.Duration
+}
+
+// state names the part of its life an entry of the given age is in.
+func (t CacheTTL) state(age time.Duration) string {
+	switch {
+	case age < t.Soft:
+		return models.CacheStateFresh
+	case age < t.Hard:
+		return models.CacheStateStale
+	default:
+		return models.CacheStateExpired
+	}
+}
+
+// lookup serves key from c according to s.ttl, calling fetch on a miss or to
+// revalidate and onFetched after every successful fetch. The returned flag
+// reports whether the value is stale.
+func lookup[T any](
+	ctx context.Context,
+	s *CachedService,
+	c cacheClient[models.CacheEntry[T]],
+	key string,
+	fetch func(ctx context.Context) (T, error),
+	onFetched func(value T),
+) (T, bool, error) {
+	entry, err := c.Get(ctx, key)
+	found := err == nil && !entry.StoredAt.IsZero()
+
+	if found {
+		age := time.Since(entry.StoredAt)
+		switch s.ttl.state(age) {
+		case models.CacheStateFresh:
+			s.logger.Info().
+				Ctx(ctx).
+				Str("key", key).
+				Msg("cache hit")
+			return entry.Value, false, nil
+		case models.CacheStateStale:
+			s.logger.Info().
+				Ctx(ctx).
+				Str("key", key).
+				Dur("age", age).
+				Msg("stale cache hit, revalidating")
+			s.revalidate(ctx, key, func(ctx context.Context) error {
+				_, err := refresh(ctx, s, c, key, fetch, onFetched)
+				return err
+			})
+			return entry.Value, true, nil
+		}
+	}
+	s.logger.Info().
+		Ctx(ctx).
+		Str("key", key).
+		Bool("expired", found).
+		Err(err).
+		Msg("cache miss")
+
+	value, err := refresh(ctx, s, c, key, fetch, onFetched)
+	if err != nil {
+		if found {
+			s.logger.Warn().
+				Ctx(ctx).
+				Str("key", key).
+				Time("stored_at", entry.StoredAt).
+				Err(err).
+				Msg("serving stale cache entry after fetch failure")
+			return entry.Value, true, nil
+		}
+		var zero T
+		return zero, false, err
+	}
+	return value, false, nil
+}
+
+// refresh fetches a value and stores it under key. Concurrent refreshes of the
+// same key share a single fetch. The shared fetch runs detached from the caller
+// that started it, under refreshTimeout, so that caller going away does not fail
+// the others; each caller stops waiting when its own ctx is done.
+func refresh[T any](
+	ctx context.Context,
+	s *CachedService,
+	c cacheClient[models.CacheEntry[T]],
+	key string,
+	fetch func(ctx context.Context) (T, error),
+	onFetched func(value T),
+) (T, error) {
+	ch := s.flight.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+		defer cancel()
+
+		value, err := fetch(ctx)
+		if err != nil {
+			s.logger.Error().
+				Ctx(ctx).
+				Str("key", key).
+				Err(err).
+				Msg("inner service failed")
+			return nil, err
+		}
+
+		entry := models.CacheEntry[T]{Value: value, StoredAt: time.Now().UTC()}
+		if err := c.Set(ctx, key, entry); err != nil {
+			s.logger.Error().
+				Ctx(ctx).
+				Str("key", key).
+				Err(err).
+				Msg("cache set failed")
+		}
+		onFetched(value)
+
+		return value, nil
+	})
+
+	var zero T
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case res := <-ch:
+		if res.Shared {
+			s.logger.Debug().
+				Ctx(ctx).
+				Str("key", key).
+				Msg("coalesced with an in-flight fetch")
+		}
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		return res.Val.(T), nil
+	}
+}
+
+// revalidate runs fn in the background unless a refresh of key is already running.
+func (s *CachedService) revalidate(ctx context.Context, key string, fn func(ctx context.Context) error) {
+	s.mu.Lock()
+	if _, ok := s.refreshing[key]; ok {
+		s.mu.Unlock()
+		return
+	}
+	s.refreshing[key] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.refreshing, key)
+			s.mu.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+		defer cancel()
+
+		if err := fn(ctx); err != nil {
+			s.logger.Warn().
+				Ctx(ctx).
+				Str("key", key).
+				Err(err).
+				Msg("background revalidation failed, keeping stale entry")
+		}
+	}()
+}
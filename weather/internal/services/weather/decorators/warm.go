@@ -0,0 +1,29 @@
+package decorators
+
+import (
+	"context"
+	"time"
+)
+
+// Warm refetches the current weather of a city unless its cached entry will
+// still be fresh at until, so that a lookup then is served from the cache. It
+// reports whether it fetched. Only the English entry is warmed.
+func (s *CachedService) Warm(ctx context.Context, input string, until time.Time) (bool, error) {
+	city := s.resolver.Resolve(input)
+	key := weatherKey(city.ID, "")
+
+	entry, err := s.cache.Get(ctx, key)
+	if err == nil && !entry.StoredAt.IsZero() && until.Sub(entry.StoredAt) < s.ttl.Soft {
+		return false, nil
+	}
+
+	if _, err := refresh(ctx, s, s.cache, key, s.cityFetcher(city, ""), s.cityPublisher(city, "")); err != nil {
+		return false, err
+	}
+	s.logger.Debug().
+		Ctx(ctx).
+		Str("key", key).
+		Time("until", until).
+		Msg("cache entry warmed")
+	return true, nil
+}
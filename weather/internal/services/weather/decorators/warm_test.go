@@ -0,0 +1,109 @@
+//go:build unit
+
+package decorators_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/watch"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather/decorators"
+)
+
+func TestCachedService_Warm(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	runAt := now.Add(30 * time.Second)
+
+	tests := []struct {
+		name        string
+		storedAt    time.Time
+		fetchErr    error
+		wantFetched bool
+		wantErr     bool
+	}{
+		{name: "FreshAtRun", storedAt: now.Add(-10 * time.Second)},
+		{name: "StaleByRun", storedAt: now.Add(-45 * time.Second), wantFetched: true},
+		{name: "Missing", wantFetched: true},
+		{name: "FetchFails", fetchErr: errors.New("providers down"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newMemoryCache[models.CacheEntry[models.WeatherData]]()
+			if !tt.storedAt.IsZero() {
+				cache.items["weather:kyiv-ua"] = models.CacheEntry[models.WeatherData]{
+					Value:    models.WeatherData{City: "Kyiv", Temperature: 10},
+					StoredAt: tt.storedAt,
+				}
+			}
+
+			inner := &mockInner{}
+			inner.On("GetByCity", mock.Anything, "Kyiv", "").
+				Return(models.WeatherData{City: "Kyiv", Temperature: 18}, tt.fetchErr).
+				Maybe()
+
+			l, err := logger.NewLogger("", "cached_service_warm")
+			require.NoError(t, err)
+
+			svc := decorators.NewCachedService(inner, newResolver(t), cache,
+				newMemoryCache[models.CacheEntry[models.Forecast]](), watch.NewHub(), testTTL, l)
+
+			fetched, err := svc.Warm(ctx, "Kyiv", runAt)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFetched, fetched)
+
+			if tt.wantFetched {
+				assert.Equal(t, 18.0, cache.items["weather:kyiv-ua"].Value.Temperature)
+			} else {
+				inner.AssertNotCalled(t, "GetByCity", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestCachedService_Seed(t *testing.T) {
+	ctx := context.Background()
+	cache := newMemoryCache[models.CacheEntry[models.WeatherData]]()
+	forecastCache := newMemoryCache[models.CacheEntry[models.Forecast]]()
+	inner := &mockInner{}
+
+	l, err := logger.NewLogger("", "cached_service_seed")
+	require.NoError(t, err)
+
+	svc := decorators.NewCachedService(inner, newResolver(t), cache, forecastCache, watch.NewHub(), testTTL, l)
+
+	forecast := models.Forecast{City: "kyiv", Days: []models.DailyForecast{
+		{Date: "2025-07-01", MaxTemperature: 20},
+		{Date: "2025-07-02", MaxTemperature: 22},
+	}}
+	require.NoError(t, svc.Seed(ctx, "kyiv", models.WeatherData{City: "kyiv", Temperature: 18}, forecast))
+
+	weather, err := svc.GetByCity(ctx, "Kyiv", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Kyiv", weather.City)
+	assert.Equal(t, 18.0, weather.Temperature)
+
+	oneDay, err := svc.GetForecast(ctx, "Kyiv", 1)
+	require.NoError(t, err)
+	assert.Equal(t, forecast.Days[:1], oneDay.Days)
+
+	twoDays, err := svc.GetForecast(ctx, "Kyiv", 2)
+	require.NoError(t, err)
+	assert.Equal(t, forecast.Days, twoDays.Days)
+
+	inner.AssertNotCalled(t, "GetByCity", mock.Anything, mock.Anything, mock.Anything)
+	inner.AssertNotCalled(t, "GetForecast", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,157 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sony/gobreaker"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// errEmptyPayload is the cause reported when a provider answers 200 without any entries.
+var errEmptyPayload = errors.New("response contains no entries")
+
+// errBudgetSpent is the cause reported when a call is refused by our own quota
+// rather than by the provider.
+var errBudgetSpent = errors.New("call budget spent")
+
+// statusKinds maps provider HTTP statuses onto error kinds; any other non-200
+// status means the provider cannot serve us right now.
+var statusKinds = map[int]error{
+	http.StatusNotFound:        models.ErrCityNotFound,
+	http.StatusTooManyRequests: models.ErrQuotaExceeded,
+}
+
+// statusCause is the cause reported for a non-200 response. It keeps the status
+// code and any Retry-After the provider sent, which the retry decorator honours.
+type statusCause struct {
+	code          int
+	status        string
+	retryAfter    time.Duration
+	hasRetryAfter bool
+}
+
+func (e *statusCause) Error() string {
+	return "status " + e.status
+}
+
+// statusError classifies a non-200 response from a provider.
+func statusError(provider string, resp *http.Response) error {
+	kind, ok := statusKinds[resp.StatusCode]
+	if !ok {
+		kind = models.ErrProviderUnavailable
+	}
+	cause := &statusCause{code: resp.StatusCode, status: resp.Status}
+	cause.retryAfter, cause.hasRetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	return models.NewProviderError(provider, kind, cause)
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an
+// HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// transportError classifies a failure to reach the provider at all. The URL
+// net/http reports is cut down to its path, since its query holds the API key.
+func transportError(provider string, err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		err = &url.Error{Op: ue.Op, URL: redactURL(ue.URL), Err: ue.Err}
+	}
+	return models.NewProviderError(provider, models.ErrProviderUnavailable, err)
+}
+
+// redactURL drops the query, credentials and fragment of a URL.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "<unparsable URL>"
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: u.Path}).String()
+}
+
+// failureSummary describes a failure without the raw error text: its kind, and
+// the status or the redacted request that failed.
+func failureSummary(err error) string {
+	summary := "error"
+	var pe *models.ProviderError
+	if errors.As(err, &pe) {
+		summary = pe.Kind.Error()
+	}
+
+	var (
+		sc *statusCause
+		ue *url.Error
+	)
+	switch {
+	case errors.As(err, &sc):
+		return summary + ": " + sc.Error()
+	case errors.Is(err, context.DeadlineExceeded):
+		return summary + ": timeout"
+	case errors.As(err, &ue):
+		if ue.Timeout() {
+			return summary + ": timeout"
+		}
+		return summary + ": " + ue.Op + " " + redactURL(ue.URL) + " failed"
+	case errors.Is(err, gobreaker.ErrOpenState), errors.Is(err, gobreaker.ErrTooManyRequests):
+		return summary + ": circuit open"
+	}
+	return summary
+}
+
+// invalidResponse classifies a payload that could not be decoded or was unusable.
+func invalidResponse(provider string, err error) error {
+	return models.NewProviderError(provider, models.ErrInvalidResponse, err)
+}
+
+// quotaError is reported for a client skipped because its budget is spent.
+func quotaError(cl Client) error {
+	return models.NewProviderError(clientName(cl), models.ErrQuotaExceeded, errBudgetSpent)
+}
+
+// dominantError picks the failure that best explains why every client failed:
+// an unknown city wins over transient trouble, and quota exhaustion is only
+// reported when it is the sole reason.
+func dominantError(errs []error) error {
+	if len(errs) == 0 {
+		return models.ErrProviderUnavailable
+	}
+	for _, err := range errs {
+		if errors.Is(err, models.ErrCityNotFound) {
+			return err
+		}
+	}
+
+	allQuota := true
+	for _, err := range errs {
+		if !errors.Is(err, models.ErrQuotaExceeded) {
+			allQuota = false
+			break
+		}
+	}
+	if allQuota {
+		return errs[0]
+	}
+
+	for _, err := range errs {
+		if errors.Is(err, models.ErrProviderUnavailable) {
+			return err
+		}
+	}
+	return errs[0]
+}
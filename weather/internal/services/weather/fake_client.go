@@ -0,0 +1,338 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// fakeProvider is the name the fake provider reports.
+const fakeProvider = "Fake"
+
+// Failures a snapshot reading can script instead of weather.
+const (
+	fakeNotFound    = "not_found"
+	fakeQuota       = "quota"
+	fakeUnavailable = "unavailable"
+)
+
+// errScripted is the cause of every failure a snapshot scripts.
+var errScripted = errors.New("scripted by the snapshot")
+
+// FakeSnapshot is the weather a fake provider serves, read from a YAML or JSON
+// file. Cities are matched case-insensitively.
+type FakeSnapshot struct {
+	// Generate answers for cities that are not listed with weather derived
+	// from their name, instead of reporting them not found.
+	Generate bool                `yaml:"generate"`
+	Cities   map[string]FakeCity `yaml:"cities"`
+}
+
+// FakeCity is the scripted weather of one city. Current readings are served in
+// turn, one per lookup, starting over after the last. A city without a forecast
+// gets one derived from its first reading.
+type FakeCity struct {
+	Lat      *float64      `yaml:"lat"` // lookups by coordinates within 0.1° match the city
+	Lon      *float64      `yaml:"lon"`
+	Current  []FakeReading `yaml:"current"`
+	Forecast []FakeDay     `yaml:"forecast"`
+}
+
+// FakeReading is one scripted lookup: current weather, or a failure when Error
+// is "not_found", "quota" or "unavailable".
+type FakeReading struct {
+	Temperature   float64          `yaml:"temperature"`
+	Condition     string           `yaml:"condition"`
+	ConditionCode models.Condition `yaml:"condition_code"`
+	Humidity      int              `yaml:"humidity"`
+	WindSpeed     float64          `yaml:"wind_speed"`
+	WindDirection int              `yaml:"wind_direction"`
+	Pressure      float64          `yaml:"pressure"`
+	FeelsLike     float64          `yaml:"feels_like"`
+	Error         string           `yaml:"error"`
+}
+
+// FakeDay is one forecast day. An empty date is filled in counting from today.
+type FakeDay struct {
+	Date                string           `yaml:"date"`
+	MinTemperature      float64          `yaml:"min_temperature"`
+	MaxTemperature      float64          `yaml:"max_temperature"`
+	Condition           string           `yaml:"condition"`
+	ConditionCode       models.Condition `yaml:"condition_code"`
+	PrecipitationChance float64          `yaml:"precipitation_chance"`
+	PrecipitationMM     float64          `yaml:"precipitation_mm"`
+}
+
+// LoadFakeSnapshot reads and validates a snapshot file.
+func LoadFakeSnapshot(path string) (FakeSnapshot, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return FakeSnapshot{}, fmt.Errorf("read fake snapshot: %w", err)
+	}
+
+	var snapshot FakeSnapshot
+	if err := yaml.Unmarshal(raw, &snapshot); err != nil {
+		return FakeSnapshot{}, fmt.Errorf("parse fake snapshot %s: %w", path, err)
+	}
+	for name, city := range snapshot.Cities {
+		if err := city.validate(); err != nil {
+			return FakeSnapshot{}, fmt.Errorf("fake snapshot city %s: %w", name, err)
+		}
+	}
+	return snapshot, nil
+}
+
+func (c FakeCity) validate() error {
+	if len(c.Current) == 0 {
+		return errors.New("at least one current reading is required")
+	}
+	if (c.Lat == nil) != (c.Lon == nil) {
+		return errors.New("lat and lon go together")
+	}
+	for _, r := range c.Current {
+		switch r.Error {
+		case "", fakeNotFound, fakeQuota, fakeUnavailable:
+		default:
+			return fmt.Errorf("error must be %q, %q or %q, got %q", fakeNotFound, fakeQuota, fakeUnavailable, r.Error)
+		}
+		if r.ConditionCode != "" && !r.ConditionCode.Valid() {
+			return fmt.Errorf("condition_code %q is not a canonical condition", r.ConditionCode)
+		}
+	}
+	for _, d := range c.Forecast {
+		if d.ConditionCode != "" && !d.ConditionCode.Valid() {
+			return fmt.Errorf("condition_code %q is not a canonical condition", d.ConditionCode)
+		}
+	}
+	return nil
+}
+
+// ClientFake serves weather from a snapshot without any network, for local runs
+// and tests.
+type ClientFake struct {
+	snapshot FakeSnapshot
+	cities   map[string]string // lower-case name -> name in the snapshot
+
+	mu   sync.Mutex
+	next map[string]int // next reading per city
+}
+
+// NewClientFake constructs a client serving snapshot.
+func NewClientFake(snapshot FakeSnapshot) *ClientFake {
+	cities := make(map[string]string, len(snapshot.Cities))
+	for name := range snapshot.Cities {
+		cities[strings.ToLower(name)] = name
+	}
+	return &ClientFake{snapshot: snapshot, cities: cities, next: make(map[string]int)}
+}
+
+// Name returns the provider name.
+func (s *ClientFake) Name() string {
+	return fakeProvider
+}
+
+// Fetch returns the next scripted reading for a city.
+func (s *ClientFake) Fetch(_ context.Context, city, _ string) (models.WeatherData, error) {
+	name, ok := s.cities[strings.ToLower(city)]
+	if !ok {
+		return s.generated(city)
+	}
+	return s.advance(name)
+}
+
+// FetchByCoordinates returns the next scripted reading for the listed city at
+// the given point.
+func (s *ClientFake) FetchByCoordinates(_ context.Context, lat, lon float64) (models.WeatherData, error) {
+	for name, city := range s.snapshot.Cities {
+		if city.Lat != nil && math.Abs(*city.Lat-lat) <= 0.1 && math.Abs(*city.Lon-lon) <= 0.1 {
+			return s.advance(name)
+		}
+	}
+	return s.generated(formatCoordinates(lat, lon))
+}
+
+// FetchForecast returns the listed forecast of a city, or one derived from its
+// first reading.
+func (s *ClientFake) FetchForecast(_ context.Context, city string, days int) (models.Forecast, error) {
+	name, ok := s.cities[strings.ToLower(city)]
+	if !ok && !s.snapshot.Generate {
+		return models.Forecast{}, models.NewProviderError(fakeProvider, models.ErrCityNotFound, errScripted)
+	}
+	if !ok {
+		return fakeForecast(city, generateReading(city), days), nil
+	}
+	return s.forecast(name, days)
+}
+
+// Preload hands the first reading and the full forecast of every listed city
+// to seed, e.g. to fill a cache ahead of the first lookup. Cities scripted to
+// fail first are left out. Reading them does not advance the script.
+func (s *ClientFake) Preload(ctx context.Context, seed func(ctx context.Context, city string, weather models.WeatherData, forecast models.Forecast) error) error {
+	names := make([]string, 0, len(s.snapshot.Cities))
+	for name := range s.snapshot.Cities {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var errs []error
+	for _, name := range names {
+		first := s.snapshot.Cities[name].Current[0]
+		if first.Error != "" {
+			continue
+		}
+		forecast, _ := s.forecast(name, models.MaxForecastDays) // only fails when the first reading does
+		if err := seed(ctx, name, first.weather(name), forecast); err != nil {
+			errs = append(errs, fmt.Errorf("preload %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// advance returns the next reading of a listed city and moves its script on.
+func (s *ClientFake) advance(name string) (models.WeatherData, error) {
+	readings := s.snapshot.Cities[name].Current
+
+	s.mu.Lock()
+	i := s.next[name]
+	s.next[name] = (i + 1) % len(readings)
+	s.mu.Unlock()
+
+	r := readings[i]
+	if r.Error != "" {
+		return models.WeatherData{}, r.err()
+	}
+	return r.weather(name), nil
+}
+
+func (s *ClientFake) generated(location string) (models.WeatherData, error) {
+	if !s.snapshot.Generate {
+		return models.WeatherData{}, models.NewProviderError(fakeProvider, models.ErrCityNotFound, errScripted)
+	}
+	return generateReading(location).weather(location), nil
+}
+
+func (s *ClientFake) forecast(name string, days int) (models.Forecast, error) {
+	city := s.snapshot.Cities[name]
+	if len(city.Forecast) == 0 {
+		first := city.Current[0]
+		if first.Error != "" {
+			return models.Forecast{}, first.err()
+		}
+		return fakeForecast(name, first, days), nil
+	}
+
+	today := time.Now().UTC()
+	forecast := models.Forecast{City: name, Days: make([]models.DailyForecast, 0, min(days, len(city.Forecast)))}
+	for i, d := range city.Forecast[:min(days, len(city.Forecast))] {
+		date := d.Date
+		if date == "" {
+			date = today.AddDate(0, 0, i).Format(time.DateOnly)
+		}
+		forecast.Days = append(forecast.Days, models.DailyForecast{
+			Date:                date,
+			MinTemperature:      d.MinTemperature,
+			MaxTemperature:      d.MaxTemperature,
+			Condition:           d.Condition,
+			ConditionCode:       conditionOrUnknown(d.ConditionCode),
+			PrecipitationChance: d.PrecipitationChance,
+			PrecipitationMM:     d.PrecipitationMM,
+		})
+	}
+	return forecast, nil
+}
+
+func (r FakeReading) weather(city string) models.WeatherData {
+	return models.WeatherData{
+		City:          city,
+		Temperature:   r.Temperature,
+		Condition:     r.Condition,
+		ConditionCode: conditionOrUnknown(r.ConditionCode),
+		Humidity:      r.Humidity,
+		WindSpeed:     r.WindSpeed,
+		WindDirection: r.WindDirection,
+		Pressure:      r.Pressure,
+		FeelsLike:     r.FeelsLike,
+		ObservedAt:    time.Now().UTC(),
+		Provider:      fakeProvider,
+	}
+}
+
+func (r FakeReading) err() error {
+	kind := models.ErrProviderUnavailable
+	switch r.Error {
+	case fakeNotFound:
+		kind = models.ErrCityNotFound
+	case fakeQuota:
+		kind = models.ErrQuotaExceeded
+	}
+	return models.NewProviderError(fakeProvider, kind, errScripted)
+}
+
+func conditionOrUnknown(c models.Condition) models.Condition {
+	if c == "" {
+		return models.ConditionUnknown
+	}
+	return c
+}
+
+// fakeConditions are the conditions generated weather picks from, with their text.
+var fakeConditions = []struct {
+	code models.Condition
+	text string
+}{
+	{models.ConditionClear, "Clear"},
+	{models.ConditionPartlyCloudy, "Partly cloudy"},
+	{models.ConditionCloudy, "Cloudy"},
+	{models.ConditionRain, "Rain"},
+	{models.ConditionSnow, "Snow"},
+	{models.ConditionFog, "Fog"},
+}
+
+// generateReading derives plausible weather from a location's name, so the same
+// location always gets the same weather.
+func generateReading(location string) FakeReading {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(strings.ToLower(location)))
+	sum := h.Sum32()
+
+	condition := fakeConditions[(sum>>8)%uint32(len(fakeConditions))]
+	temperature := float64(sum%700)/20 - 10 // -10 to 25 °C in 0.05° steps
+	return FakeReading{
+		Temperature:   temperature,
+		Condition:     condition.text,
+		ConditionCode: condition.code,
+		Humidity:      40 + int((sum>>16)%50),
+		WindSpeed:     float64((sum>>24)%100) / 10,
+		WindDirection: int((sum >> 4) % 360),
+		Pressure:      1000 + float64((sum>>12)%30),
+		FeelsLike:     temperature - 1,
+	}
+}
+
+// fakeForecast derives a forecast from one reading: its condition every day,
+// with the temperature range swinging around its temperature.
+func fakeForecast(city string, r FakeReading, days int) models.Forecast {
+	today := time.Now().UTC()
+	forecast := models.Forecast{City: city, Days: make([]models.DailyForecast, 0, days)}
+	for i := range days {
+		forecast.Days = append(forecast.Days, models.DailyForecast{
+			Date:           today.AddDate(0, 0, i).Format(time.DateOnly),
+			MinTemperature: r.Temperature - 4 + float64(i%3),
+			MaxTemperature: r.Temperature + 4 - float64(i%2),
+			Condition:      r.Condition,
+			ConditionCode:  conditionOrUnknown(r.ConditionCode),
+		})
+	}
+	return forecast
+}
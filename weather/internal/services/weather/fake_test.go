@@ -0,0 +1,165 @@
+//go:build unit
+
+package weather_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather"
+)
+
+const fakeSnapshot = `
+cities:
+  Lviv:
+    lat: 49.84
+    lon: 24.03
+    current:
+      - {temperature: 14.5, condition: Light rain, condition_code: rain, humidity: 81}
+      - {temperature: 16, condition: Sunny, condition_code: clear}
+      - {error: quota}
+    forecast:
+      - {date: "2025-07-01", min_temperature: 11, max_temperature: 19, condition: Sunny, condition_code: clear}
+      - {min_temperature: 12, max_temperature: 21, condition: Showers, condition_code: rain}
+  Atlantis:
+    current:
+      - {error: not_found}
+`
+
+func writeFakeSnapshot(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "snapshot.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func newFakeClient(t *testing.T, content string) *weather.ClientFake {
+	t.Helper()
+	snapshot, err := weather.LoadFakeSnapshot(writeFakeSnapshot(t, content))
+	require.NoError(t, err)
+	return weather.NewClientFake(snapshot)
+}
+
+func Test_Fake_Fetch_Scripted(t *testing.T) {
+	client := newFakeClient(t, fakeSnapshot)
+	ctx := context.Background()
+	assert.Equal(t, "Fake", client.Name())
+
+	first, err := client.Fetch(ctx, "lviv", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Lviv", first.City)
+	assert.Equal(t, 14.5, first.Temperature)
+	assert.Equal(t, models.ConditionRain, first.ConditionCode)
+	assert.Equal(t, 81, first.Humidity)
+	assert.Equal(t, "Fake", first.Provider)
+
+	second, err := client.Fetch(ctx, "Lviv", "")
+	require.NoError(t, err)
+	assert.Equal(t, 16.0, second.Temperature)
+
+	_, err = client.Fetch(ctx, "Lviv", "")
+	assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+
+	again, err := client.Fetch(ctx, "Lviv", "")
+	require.NoError(t, err)
+	assert.Equal(t, 14.5, again.Temperature)
+
+	_, err = client.Fetch(ctx, "Atlantis", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
+	_, err = client.Fetch(ctx, "Nowhere", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
+}
+
+func Test_Fake_FetchByCoordinates(t *testing.T) {
+	client := newFakeClient(t, fakeSnapshot)
+
+	data, err := client.FetchByCoordinates(context.Background(), 49.8, 24.0)
+	require.NoError(t, err)
+	assert.Equal(t, "Lviv", data.City)
+
+	_, err = client.FetchByCoordinates(context.Background(), 10, 10)
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
+}
+
+func Test_Fake_FetchForecast(t *testing.T) {
+	forecast, err := newFakeClient(t, fakeSnapshot).FetchForecast(context.Background(), "Lviv", 3)
+	require.NoError(t, err)
+
+	require.Len(t, forecast.Days, 2)
+	assert.Equal(t, "2025-07-01", forecast.Days[0].Date)
+	assert.Equal(t, time.Now().UTC().AddDate(0, 0, 1).Format(time.DateOnly), forecast.Days[1].Date)
+	assert.Equal(t, models.ConditionRain, forecast.Days[1].ConditionCode)
+}
+
+func Test_Fake_Generate(t *testing.T) {
+	client := newFakeClient(t, "generate: true")
+	ctx := context.Background()
+
+	first, err := client.Fetch(ctx, "Odesa", "")
+	require.NoError(t, err)
+	second, err := client.Fetch(ctx, "ODESA", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, first.Temperature, second.Temperature)
+	assert.Equal(t, first.Condition, second.Condition)
+	assert.True(t, first.ConditionCode.Valid())
+
+	forecast, err := client.FetchForecast(ctx, "Odesa", 5)
+	require.NoError(t, err)
+	assert.Len(t, forecast.Days, 5)
+	assert.Equal(t, first.ConditionCode, forecast.Days[0].ConditionCode)
+}
+
+func Test_Fake_Preload(t *testing.T) {
+	client := newFakeClient(t, fakeSnapshot)
+
+	seeded := map[string]models.Forecast{}
+	err := client.Preload(context.Background(),
+		func(_ context.Context, city string, data models.WeatherData, forecast models.Forecast) error {
+			assert.Equal(t, 14.5, data.Temperature)
+			seeded[city] = forecast
+			return nil
+		})
+	require.NoError(t, err)
+
+	// Atlantis fails first and is left out.
+	require.Len(t, seeded, 1)
+	assert.Len(t, seeded["Lviv"].Days, 2)
+
+	// Preloading does not use up the script.
+	data, err := client.Fetch(context.Background(), "Lviv", "")
+	require.NoError(t, err)
+	assert.Equal(t, 14.5, data.Temperature)
+}
+
+func Test_LoadFakeSnapshot_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "NoReadings", content: `cities: {Lviv: {current: []}}`},
+		{name: "UnknownError", content: `cities: {Lviv: {current: [{error: meteor}]}}`},
+		{name: "UnknownCondition", content: `cities: {Lviv: {current: [{condition_code: drizzly}]}}`},
+		{name: "LatWithoutLon", content: `cities: {Lviv: {lat: 49.84, current: [{temperature: 1}]}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := weather.LoadFakeSnapshot(writeFakeSnapshot(t, tt.content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_LoadFakeSnapshot_Example(t *testing.T) {
+	snapshot, err := weather.LoadFakeSnapshot("../../../fake-snapshot.example.yaml")
+	require.NoError(t, err)
+	assert.True(t, snapshot.Generate)
+	assert.Contains(t, snapshot.Cities, "Kyiv")
+}
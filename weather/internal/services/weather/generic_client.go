@@ -0,0 +1,331 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// errUnsupported is the cause reported for a lookup a provider is not set up
+// for. It says nothing about the provider's health.
+var errUnsupported = errors.New("not supported by this provider")
+
+// ClientGeneric fetches weather data from any JSON API described by a GenericConfig.
+type ClientGeneric struct {
+	cfg    GenericConfig
+	client HTTPClient
+	logger zerolog.Logger
+}
+
+// NewClientGeneric constructs a client for the provider cfg describes.
+func NewClientGeneric(cfg GenericConfig, httpClient HTTPClient, logger zerolog.Logger) *ClientGeneric {
+	return &ClientGeneric{cfg: cfg, client: httpClient, logger: logger}
+}
+
+// Name returns the configured provider name.
+func (s *ClientGeneric) Name() string {
+	return s.cfg.Name
+}
+
+// Fetch retrieves weather data for a given city, with structured logging.
+func (s *ClientGeneric) Fetch(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	u := expandURL(s.cfg.URL, map[string]string{"city": city, "lang": lang})
+	data, err := s.current(ctx, u, city)
+	if err != nil {
+		return models.WeatherData{}, err
+	}
+	if data.City == "" {
+		data.City = city
+	}
+	return data, nil
+}
+
+// FetchByCoordinates retrieves weather data for the given point, when the
+// provider has a coordinates URL.
+func (s *ClientGeneric) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	location := formatCoordinates(lat, lon)
+	if s.cfg.CoordinatesURL == "" {
+		return models.WeatherData{}, models.NewProviderError(s.cfg.Name, models.ErrProviderUnavailable, errUnsupported)
+	}
+	u := expandURL(s.cfg.CoordinatesURL, map[string]string{
+		"lat": formatCoordinate(lat),
+		"lon": formatCoordinate(lon),
+	})
+	data, err := s.current(ctx, u, location)
+	if err != nil {
+		return models.WeatherData{}, err
+	}
+	if data.City == "" {
+		data.City = location
+	}
+	return data, nil
+}
+
+// current requests the current weather at u and maps it; location only labels the logs.
+func (s *ClientGeneric) current(ctx context.Context, u, location string) (models.WeatherData, error) {
+	start := time.Now()
+
+	doc, err := s.get(ctx, u, location)
+	if err != nil {
+		return models.WeatherData{}, err
+	}
+
+	m := s.cfg.Current
+	temperature, err := pathFloat(doc, m.Temperature)
+	if err != nil {
+		return models.WeatherData{}, s.invalid(ctx, location, err)
+	}
+	condition, ok := pathString(doc, m.Condition)
+	if !ok {
+		return models.WeatherData{}, s.invalid(ctx, location, fmt.Errorf("no value at %q", m.Condition))
+	}
+
+	data := models.WeatherData{
+		Temperature:   temperature,
+		Condition:     condition,
+		ConditionCode: s.condition(doc, m.ConditionCode),
+		Provider:      s.cfg.Name,
+	}
+	if m.City != "" {
+		data.City, _ = pathString(doc, m.City)
+	}
+	// Optional fields are left zero when missing rather than failing the lookup.
+	data.Humidity = int(optionalFloat(doc, m.Humidity))
+	data.WindSpeed = optionalFloat(doc, m.WindSpeed)
+	data.WindDirection = int(optionalFloat(doc, m.WindDirection))
+	data.Pressure = optionalFloat(doc, m.Pressure)
+	data.FeelsLike = optionalFloat(doc, m.FeelsLike)
+	data.ObservedAt = time.Now().UTC()
+	if m.ObservedAt != "" {
+		if t, err := pathTime(doc, m.ObservedAt); err == nil {
+			data.ObservedAt = t
+		}
+	}
+
+	s.logger.Info().
+		Ctx(ctx).
+		Str("provider", s.cfg.Name).
+		Str("location", location).
+		Dur("duration_ms", time.Since(start)).
+		Msg("successfully fetched weather data")
+
+	return data, nil
+}
+
+// FetchForecast retrieves a daily forecast for a given city, when the provider
+// has a forecast URL.
+func (s *ClientGeneric) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	start := time.Now()
+	if s.cfg.ForecastURL == "" {
+		return models.Forecast{}, models.NewProviderError(s.cfg.Name, models.ErrProviderUnavailable, errUnsupported)
+	}
+
+	u := expandURL(s.cfg.ForecastURL, map[string]string{"city": city, "days": strconv.Itoa(days)})
+	doc, err := s.get(ctx, u, city)
+	if err != nil {
+		return models.Forecast{}, err
+	}
+
+	m := s.cfg.Forecast
+	list, _ := lookupPath(doc, m.Days)
+	entries, _ := list.([]any)
+	if len(entries) == 0 {
+		return models.Forecast{}, s.invalid(ctx, city, errEmptyPayload)
+	}
+
+	forecast := models.Forecast{City: city, Days: make([]models.DailyForecast, 0, len(entries))}
+	for _, entry := range entries[:min(days, len(entries))] {
+		date, ok := pathString(entry, m.Date)
+		if !ok {
+			return models.Forecast{}, s.invalid(ctx, city, fmt.Errorf("no value at %q", m.Date))
+		}
+		day := models.DailyForecast{
+			Date:                date,
+			MinTemperature:      optionalFloat(entry, m.MinTemperature),
+			MaxTemperature:      optionalFloat(entry, m.MaxTemperature),
+			ConditionCode:       s.condition(entry, m.ConditionCode),
+			PrecipitationChance: optionalFloat(entry, m.PrecipitationChance),
+			PrecipitationMM:     optionalFloat(entry, m.PrecipitationMM),
+		}
+		if m.Condition != "" {
+			day.Condition, _ = pathString(entry, m.Condition)
+		}
+		forecast.Days = append(forecast.Days, day)
+	}
+
+	s.logger.Info().
+		Ctx(ctx).
+		Str("provider", s.cfg.Name).
+		Str("city", city).
+		Int("days", len(forecast.Days)).
+		Dur("duration_ms", time.Since(start)).
+		Msg("successfully fetched forecast data")
+
+	return forecast, nil
+}
+
+// get requests u with the configured authentication and decodes the JSON
+// response, detecting errors reported in the body whatever the status.
+func (s *ClientGeneric) get(ctx context.Context, u, location string) (any, error) {
+	s.logger.Debug().
+		Ctx(ctx).
+		Str("provider", s.cfg.Name).
+		Str("location", location).
+		Str("url", u).
+		Msg("starting generic provider request")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("provider", s.cfg.Name).
+			Str("location", location).
+			Msg("failed to create HTTP request")
+		return nil, err
+	}
+	switch s.cfg.Auth.In {
+	case authQuery:
+		q := req.URL.Query()
+		q.Set(s.cfg.Auth.Name, s.cfg.Auth.key)
+		req.URL.RawQuery = q.Encode()
+	case authHeader:
+		req.Header.Set(s.cfg.Auth.Name, s.cfg.Auth.key)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("provider", s.cfg.Name).
+			Str("location", location).
+			Msg("error sending HTTP request to generic provider")
+		return nil, transportError(s.cfg.Name, err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			s.logger.Error().
+				Err(cerr).
+				Ctx(ctx).
+				Str("location", location).
+				Msg("failed to close response body")
+		}
+	}()
+
+	var doc any
+	decodeErr := json.NewDecoder(resp.Body).Decode(&doc)
+
+	// An error in the body is reported as is unless the status already says more:
+	// an unclassified code on a non-200 response is left to statusError, which
+	// keeps the status and any Retry-After.
+	if decodeErr == nil {
+		err := s.bodyError(doc)
+		if err != nil && (resp.StatusCode == http.StatusOK || !errors.Is(err, models.ErrProviderUnavailable)) {
+			s.logger.Error().
+				Err(err).
+				Ctx(ctx).
+				Str("provider", s.cfg.Name).
+				Str("location", location).
+				Str("status", resp.Status).
+				Msg("generic provider reported an error")
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("provider", s.cfg.Name).
+			Str("location", location).
+			Str("status", resp.Status).
+			Msg("generic provider returned non-200 status")
+		return nil, statusError(s.cfg.Name, resp)
+	}
+	if decodeErr != nil {
+		return nil, s.invalid(ctx, location, decodeErr)
+	}
+	return doc, nil
+}
+
+// bodyError classifies an error the provider reported in the response body, if any.
+func (s *ClientGeneric) bodyError(doc any) error {
+	e := s.cfg.Errors
+	if e.Path == "" {
+		return nil
+	}
+	code, ok := pathString(doc, e.Path)
+	if !ok {
+		return nil
+	}
+
+	cause := "error " + code
+	if msg, ok := pathString(doc, e.Message); ok && e.Message != "" {
+		cause += ": " + msg
+	}
+
+	kind := models.ErrProviderUnavailable
+	switch {
+	case slices.Contains(e.NotFound, code):
+		kind = models.ErrCityNotFound
+	case slices.Contains(e.Quota, code):
+		kind = models.ErrQuotaExceeded
+	}
+	return models.NewProviderError(s.cfg.Name, kind, errors.New(cause))
+}
+
+// condition maps the provider's condition code at path onto a canonical condition.
+func (s *ClientGeneric) condition(doc any, path string) models.Condition {
+	if path == "" {
+		return models.ConditionUnknown
+	}
+	code, ok := pathString(doc, path)
+	if !ok {
+		return models.ConditionUnknown
+	}
+	if c, ok := s.cfg.Conditions[code]; ok {
+		return c
+	}
+	return models.ConditionUnknown
+}
+
+func (s *ClientGeneric) invalid(ctx context.Context, location string, err error) error {
+	s.logger.Error().
+		Err(err).
+		Ctx(ctx).
+		Str("provider", s.cfg.Name).
+		Str("location", location).
+		Msg("failed to map generic provider response")
+	return invalidResponse(s.cfg.Name, err)
+}
+
+// optionalFloat returns the number at path, or zero when there is none.
+func optionalFloat(doc any, path string) float64 {
+	if path == "" {
+		return 0
+	}
+	f, err := pathFloat(doc, path)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// expandURL fills a URL template's {name} placeholders with query-escaped values.
+// Placeholders without a value are left empty.
+func expandURL(template string, values map[string]string) string {
+	for _, name := range []string{"city", "lat", "lon", "lang", "days"} {
+		template = strings.ReplaceAll(template, "{"+name+"}", url.QueryEscape(values[name]))
+	}
+	return template
+}
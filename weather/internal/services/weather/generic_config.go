@@ -0,0 +1,163 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// builtinProviders are the names taken by the providers written in code. Quotas,
+// retries, weights and metrics are keyed by provider name, so a generic provider
+// may not reuse one of them.
+var builtinProviders = []string{providerWeatherAPI, providerOpenWeather, providerWeatherBit, providerOpenMeteo, fakeProvider}
+
+// Where a generic provider takes its API key.
+const (
+	authQuery  = "query"
+	authHeader = "header"
+)
+
+// GenericConfig describes a JSON weather API declaratively, so a provider can be
+// added through configuration instead of a new client. URLs are templates:
+// {city}, {lat}, {lon}, {lang} and {days} are replaced by the query-escaped
+// values of a lookup. Mappings are JSONPath-style paths into the response, see
+// lookupPath.
+type GenericConfig struct {
+	Name string `yaml:"name"`
+
+	URL            string `yaml:"url"`             // current weather by {city}
+	CoordinatesURL string `yaml:"coordinates_url"` // current weather by {lat} and {lon}; optional
+	ForecastURL    string `yaml:"forecast_url"`    // daily forecast by {city} and {days}; optional
+
+	Auth GenericAuth `yaml:"auth"`
+
+	Current  GenericCurrent  `yaml:"current"`
+	Forecast GenericForecast `yaml:"forecast"`
+	Errors   GenericErrors   `yaml:"errors"`
+
+	// Conditions maps the provider's condition codes, as found at
+	// condition_code, onto canonical conditions.
+	Conditions map[string]models.Condition `yaml:"conditions"`
+}
+
+// GenericAuth places the API key, read from the environment variable Env so
+// that the file holds no secrets, in a query parameter or a header called Name.
+type GenericAuth struct {
+	In   string `yaml:"in"` // "query", "header" or empty for no key
+	Name string `yaml:"name"`
+	Env  string `yaml:"env"`
+
+	key string
+}
+
+// GenericCurrent maps a current weather response. Temperature and condition are
+// required; the rest are optional.
+type GenericCurrent struct {
+	City          string `yaml:"city"`
+	Temperature   string `yaml:"temperature"`
+	Condition     string `yaml:"condition"`
+	ConditionCode string `yaml:"condition_code"`
+	Humidity      string `yaml:"humidity"`
+	WindSpeed     string `yaml:"wind_speed"` // m/s
+	WindDirection string `yaml:"wind_direction"`
+	Pressure      string `yaml:"pressure"`
+	FeelsLike     string `yaml:"feels_like"`
+	ObservedAt    string `yaml:"observed_at"` // Unix seconds or RFC 3339
+}
+
+// GenericForecast maps a forecast response: Days points at the array of days,
+// and every other path is relative to one day.
+type GenericForecast struct {
+	Days                string `yaml:"days"`
+	Date                string `yaml:"date"`
+	MinTemperature      string `yaml:"min_temperature"`
+	MaxTemperature      string `yaml:"max_temperature"`
+	Condition           string `yaml:"condition"`
+	ConditionCode       string `yaml:"condition_code"`
+	PrecipitationChance string `yaml:"precipitation_chance"`
+	PrecipitationMM     string `yaml:"precipitation_mm"`
+}
+
+// GenericErrors detects errors reported in a response body. A value at Path,
+// whatever the status, is an error; it is classified by the codes listed for
+// each kind and is a provider failure otherwise.
+type GenericErrors struct {
+	Path     string   `yaml:"path"`
+	Message  string   `yaml:"message"`
+	NotFound []string `yaml:"not_found"`
+	Quota    []string `yaml:"quota"`
+}
+
+// LoadGenericConfigs reads generic provider definitions from a YAML or JSON file
+// holding a "providers" list, and the API keys they name from the environment.
+func LoadGenericConfigs(path string) ([]GenericConfig, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read generic providers: %w", err)
+	}
+
+	var file struct {
+		Providers []GenericConfig `yaml:"providers"`
+	}
+	if err := yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("parse generic providers %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool, len(file.Providers))
+	for i := range file.Providers {
+		cfg := &file.Providers[i]
+		if err := cfg.validate(); err != nil {
+			return nil, fmt.Errorf("generic provider %d (%s): %w", i+1, cfg.Name, err)
+		}
+		if slices.ContainsFunc(builtinProviders, func(name string) bool { return strings.EqualFold(name, cfg.Name) }) {
+			return nil, fmt.Errorf("generic provider %s: name is taken by a built-in provider", cfg.Name)
+		}
+		if seen[strings.ToLower(cfg.Name)] {
+			return nil, fmt.Errorf("generic provider %s: name is used more than once", cfg.Name)
+		}
+		seen[strings.ToLower(cfg.Name)] = true
+		if cfg.Auth.Env != "" {
+			cfg.Auth.key = os.Getenv(cfg.Auth.Env)
+			if cfg.Auth.key == "" {
+				return nil, fmt.Errorf("generic provider %s: %s is not set", cfg.Name, cfg.Auth.Env)
+			}
+		}
+	}
+	return file.Providers, nil
+}
+
+func (c *GenericConfig) validate() error {
+	switch {
+	case c.Name == "":
+		return errors.New("name is required")
+	case c.URL == "":
+		return errors.New("url is required")
+	case c.Current.Temperature == "" || c.Current.Condition == "":
+		return errors.New("current.temperature and current.condition are required")
+	case c.ForecastURL != "" && (c.Forecast.Days == "" || c.Forecast.Date == ""):
+		return errors.New("forecast.days and forecast.date are required with forecast_url")
+	}
+
+	switch c.Auth.In {
+	case "":
+	case authQuery, authHeader:
+		if c.Auth.Name == "" || c.Auth.Env == "" {
+			return errors.New("auth.name and auth.env are required with auth.in")
+		}
+	default:
+		return fmt.Errorf("auth.in must be %q or %q, got %q", authQuery, authHeader, c.Auth.In)
+	}
+
+	for code, condition := range c.Conditions {
+		if !condition.Valid() {
+			return fmt.Errorf("condition %q for code %q is not a canonical condition", condition, code)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+//go:build unit
+
+package weather_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather"
+)
+
+const genericProviders = `
+providers:
+  - name: Acme
+    url: {{server}}/current?q={city}
+    coordinates_url: {{server}}/current?lat={lat}&lon={lon}
+    forecast_url: {{server}}/forecast?q={city}&days={days}
+    auth: {in: header, name: X-Api-Key, env: ACME_API_KEY}
+    current:
+      city: $.place.name
+      temperature: $.data[0].temp
+      condition: $.data[0].weather.text
+      condition_code: data.0.weather.code
+      humidity: $.data[0].rh
+      wind_speed: $.data[0].wind
+      observed_at: $.data[0].ts
+    forecast:
+      days: $.days
+      date: date
+      min_temperature: min
+      max_temperature: max
+      condition: text
+      condition_code: code
+    conditions: {"10": rain, "20": clear}
+    errors:
+      path: $.error.code
+      message: $.error.message
+      not_found: ["NO_CITY"]
+      quota: ["LIMIT"]
+`
+
+// newGenericServer answers like a JSON weather API with its own layout and
+// checks the API key is sent in the configured header.
+func newGenericServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/current", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+		switch r.URL.Query().Get("q") {
+		case "Atlantis":
+			_, _ = w.Write([]byte(`{"error": {"code": "NO_CITY", "message": "unknown place"}}`))
+		case "Busy":
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error": {"code": "LIMIT"}}`))
+		case "Broken":
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`{"error": {"code": "UPSTREAM"}}`))
+		default:
+			_, _ = w.Write([]byte(`{
+			  "place": {"name": "Lviv"},
+			  "data": [{"temp": "14.5", "rh": 81, "wind": 4.2, "ts": 1751371200,
+			            "weather": {"text": "Light rain", "code": 10}}]
+			}`))
+		}
+	})
+	mux.HandleFunc("/forecast", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("days"))
+		_, _ = w.Write([]byte(`{"days": [
+		  {"date": "2025-07-01", "min": 11, "max": 19, "text": "Sunny", "code": 20},
+		  {"date": "2025-07-02", "min": 12, "max": 21, "text": "Showers", "code": 10},
+		  {"date": "2025-07-03", "min": 13, "max": 22, "text": "Sunny", "code": 20}
+		]}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func writeGenericProviders(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "providers.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func newGenericClient(t *testing.T) *weather.ClientGeneric {
+	t.Helper()
+	srv := newGenericServer(t)
+	t.Setenv("ACME_API_KEY", "secret")
+
+	content := strings.ReplaceAll(genericProviders, "{{server}}", srv.URL)
+	cfgs, err := weather.LoadGenericConfigs(writeGenericProviders(t, content))
+	require.NoError(t, err)
+	require.Len(t, cfgs, 1)
+
+	l, err := logger.NewLogger("", "generic_test")
+	require.NoError(t, err)
+	return weather.NewClientGeneric(cfgs[0], srv.Client(), l)
+}
+
+func Test_Generic_Fetch_Success(t *testing.T) {
+	client := newGenericClient(t)
+	assert.Equal(t, "Acme", client.Name())
+
+	data, err := client.Fetch(context.Background(), "Lviv", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Lviv", data.City)
+	assert.Equal(t, 14.5, data.Temperature)
+	assert.Equal(t, "Light rain", data.Condition)
+	assert.Equal(t, models.ConditionRain, data.ConditionCode)
+	assert.Equal(t, 81, data.Humidity)
+	assert.Equal(t, 4.2, data.WindSpeed)
+	assert.Equal(t, time.Unix(1751371200, 0).UTC(), data.ObservedAt)
+	assert.Equal(t, "Acme", data.Provider)
+}
+
+func Test_Generic_Fetch_Errors(t *testing.T) {
+	client := newGenericClient(t)
+
+	tests := []struct {
+		city string
+		want error
+	}{
+		{city: "Atlantis", want: models.ErrCityNotFound},
+		{city: "Busy", want: models.ErrQuotaExceeded},
+		{city: "Broken", want: models.ErrProviderUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.city, func(t *testing.T) {
+			data, err := client.Fetch(context.Background(), tt.city, "")
+			assert.ErrorIs(t, err, tt.want)
+			assert.Equal(t, models.WeatherData{}, data)
+		})
+	}
+}
+
+func Test_Generic_FetchByCoordinates(t *testing.T) {
+	data, err := newGenericClient(t).FetchByCoordinates(context.Background(), 49.84, 24.03)
+	require.NoError(t, err)
+	assert.Equal(t, 14.5, data.Temperature)
+}
+
+func Test_Generic_FetchForecast(t *testing.T) {
+	forecast, err := newGenericClient(t).FetchForecast(context.Background(), "Lviv", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.DailyForecast{
+		{Date: "2025-07-01", MinTemperature: 11, MaxTemperature: 19, Condition: "Sunny", ConditionCode: models.ConditionClear},
+		{Date: "2025-07-02", MinTemperature: 12, MaxTemperature: 21, Condition: "Showers", ConditionCode: models.ConditionRain},
+	}, forecast.Days)
+}
+
+func Test_Generic_ForecastNotConfigured(t *testing.T) {
+	cfgs, err := weather.LoadGenericConfigs(writeGenericProviders(t, `{"providers": [{
+	  "name": "Bare", "url": "http://127.0.0.1:1/current?q={city}",
+	  "current": {"temperature": "temp", "condition": "text"}
+	}]}`))
+	require.NoError(t, err)
+
+	l, err := logger.NewLogger("", "generic_test_bare")
+	require.NoError(t, err)
+
+	_, err = weather.NewClientGeneric(cfgs[0], http.DefaultClient, l).FetchForecast(context.Background(), "Lviv", 3)
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
+}
+
+func Test_LoadGenericConfigs_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "MissingTemperature", content: `providers: [{name: A, url: "http://a", current: {condition: c}}]`},
+		{name: "UnknownAuthPlacement", content: `providers: [{name: A, url: "http://a", current: {temperature: t, condition: c},
+		  auth: {in: cookie, name: k, env: ACME_API_KEY}}]`},
+		{name: "UnknownCondition", content: `providers: [{name: A, url: "http://a", current: {temperature: t, condition: c},
+		  conditions: {"1": drizzly}}]`},
+		{name: "KeyNotSet", content: `providers: [{name: A, url: "http://a", current: {temperature: t, condition: c},
+		  auth: {in: query, name: key, env: UNSET_GENERIC_KEY}}]`},
+		{name: "BuiltinName", content: `providers: [{name: openmeteo, url: "http://a", current: {temperature: t, condition: c}}]`},
+		{name: "DuplicateName", content: `providers: [{name: A, url: "http://a", current: {temperature: t, condition: c}},
+		  {name: a, url: "http://b", current: {temperature: t, condition: c}}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := weather.LoadGenericConfigs(writeGenericProviders(t, tt.content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_LoadGenericConfigs_Example(t *testing.T) {
+	t.Setenv("WEATHER_API_KEY", "secret")
+
+	cfgs, err := weather.LoadGenericConfigs("../../../generic-providers.example.yaml")
+	require.NoError(t, err)
+	require.Len(t, cfgs, 1)
+	assert.Equal(t, "WeatherAPIGeneric", cfgs[0].Name)
+}
@@ -0,0 +1,123 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+// hedgeQuantile is the latency quantile used as the adaptive hedge delay.
+const hedgeQuantile = 0.95
+
+type hedgeResult struct {
+	client int
+	data   models.WeatherData
+	err    error
+}
+
+// hedge asks clients in ranked order like failover, but when a client has not
+// answered within its hedge delay the next one is fired without cancelling it.
+// A failure fires the next client straight away. The first success wins and the
+// rest are cancelled.
+func (s *ServiceProvider) hedge(ctx context.Context, q query) (models.WeatherData, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	order, errs := s.candidates()
+	results := make(chan hedgeResult, len(order))
+	launched := make([]int, 0, len(order))
+	launch := func() bool {
+		if len(launched) == len(order) {
+			return false
+		}
+		i := order[len(launched)]
+		launched = append(launched, i)
+
+		s.logger.Info().
+			Ctx(ctx).
+			Str("client", clientName(s.clients[i])).
+			Str("location", q.location).
+			Msg("calling Fetch")
+		go func() {
+			data, err := s.fetch(ctx, i, q)
+			results <- hedgeResult{client: i, data: data, err: err}
+		}()
+		return true
+	}
+
+	inFlight := 0
+	if launch() {
+		inFlight++
+	}
+	timer := time.NewTimer(s.hedgeDelay(launched))
+	defer timer.Stop()
+
+	for inFlight > 0 {
+		select {
+		case <-timer.C:
+			if launch() {
+				inFlight++
+				s.logger.Info().
+					Ctx(ctx).
+					Str("client", clientName(s.clients[launched[len(launched)-1]])).
+					Msg("hedging slow fetch")
+				timer.Reset(s.hedgeDelay(launched))
+			}
+		case res := <-results:
+			inFlight--
+			if res.err != nil {
+				s.logger.Error().
+					Ctx(ctx).
+					Str("client", clientName(s.clients[res.client])).
+					Err(res.err).
+					Msg("fetch failed")
+				errs = append(errs, res.err)
+				if launch() {
+					inFlight++
+					timer.Reset(s.hedgeDelay(launched))
+				}
+				continue
+			}
+
+			s.logger.Info().
+				Ctx(ctx).
+				Str("client", clientName(s.clients[res.client])).
+				Int("raced", len(launched)).
+				Msg("fetch succeeded")
+			s.reportHedge(launched, res.client)
+			return res.data, nil
+		}
+	}
+
+	err := fmt.Errorf("all weather API clients failed: %w", dominantError(errs))
+	s.logger.Error().
+		Err(err).
+		Ctx(ctx).
+		Str("location", q.location).
+		Msg("hedged lookup giving up")
+	return models.WeatherData{}, err
+}
+
+// hedgeDelay is how long to wait on the most recently launched client before
+// firing the next one.
+func (s *ServiceProvider) hedgeDelay(launched []int) time.Duration {
+	if len(launched) > 0 && s.cfg.HedgeAdaptive {
+		if d, ok := s.stats[launched[len(launched)-1]].latency(hedgeQuantile); ok {
+			return d
+		}
+	}
+	return s.cfg.HedgeDelay
+}
+
+// reportHedge reports the winner and losers of a race. Requests that never had
+// to race are not reported.
+func (s *ServiceProvider) reportHedge(launched []int, winner int) {
+	if s.cfg.OnHedgeResult == nil || len(launched) < 2 {
+		return
+	}
+	for _, i := range launched {
+		s.cfg.OnHedgeResult(clientName(s.clients[i]), i == winner)
+	}
+}
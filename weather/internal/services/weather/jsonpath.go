@@ -0,0 +1,97 @@
+package weather
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// lookupPath walks a decoded JSON document along a JSONPath-style path such as
+// "$.data[0].weather.code" or "data.0.weather.code". A leading "$" is optional,
+// and array elements are picked by "[n]" or a numeric segment. The second result
+// is false when the path leads nowhere or ends at null.
+func lookupPath(doc any, path string) (any, bool) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return doc, doc != nil
+	}
+
+	cur := doc
+	for _, segment := range strings.Split(strings.ReplaceAll(path, "[", ".["), ".") {
+		if segment == "" {
+			continue
+		}
+		switch node := cur.(type) {
+		case map[string]any:
+			next, ok := node[segment]
+			if !ok {
+				return nil, false
+			}
+			cur = next
+		case []any:
+			i, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(segment, "["), "]"))
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			cur = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return cur, cur != nil
+}
+
+// pathString returns the value at path as text; numbers are rendered without
+// a trailing ".0" so that codes compare equal however the provider sends them.
+func pathString(doc any, path string) (string, bool) {
+	v, ok := lookupPath(doc, path)
+	if !ok {
+		return "", false
+	}
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// pathFloat returns the value at path as a number, accepting numeric strings.
+func pathFloat(doc any, path string) (float64, error) {
+	v, ok := lookupPath(doc, path)
+	if !ok {
+		return 0, fmt.Errorf("no value at %q", path)
+	}
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value at %q: %w", path, err)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("value at %q is not a number", path)
+	}
+}
+
+// pathTime returns the value at path as a time, given either as Unix seconds or
+// as an RFC 3339 string.
+func pathTime(doc any, path string) (time.Time, error) {
+	if s, ok := pathString(doc, path); ok {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	epoch, err := pathFloat(doc, path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(epoch), 0).UTC(), nil
+}
@@ -0,0 +1,282 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+const (
+	providerOpenMeteo = "OpenMeteo"
+
+	openMeteoCurrentFields = "temperature_2m,relative_humidity_2m,apparent_temperature,weather_code," +
+		"pressure_msl,wind_speed_10m,wind_direction_10m"
+	openMeteoDailyFields = "weather_code,temperature_2m_max,temperature_2m_min," +
+		"precipitation_sum,precipitation_probability_max"
+)
+
+// openMeteoCurrent is the current conditions payload of the Open-Meteo forecast API.
+type openMeteoCurrent struct {
+	Current struct {
+		Time          int64   `json:"time"`
+		Temperature   float64 `json:"temperature_2m"`
+		Humidity      int     `json:"relative_humidity_2m"`
+		FeelsLike     float64 `json:"apparent_temperature"`
+		WeatherCode   int     `json:"weather_code"`
+		Pressure      float64 `json:"pressure_msl"`
+		WindSpeed     float64 `json:"wind_speed_10m"`
+		WindDirection int     `json:"wind_direction_10m"`
+	} `json:"current"`
+}
+
+// openMeteoDaily is the daily forecast payload of the Open-Meteo forecast API;
+// every field is a column indexed like Time.
+type openMeteoDaily struct {
+	Daily struct {
+		Time                []string  `json:"time"`
+		WeatherCode         []int     `json:"weather_code"`
+		TemperatureMax      []float64 `json:"temperature_2m_max"`
+		TemperatureMin      []float64 `json:"temperature_2m_min"`
+		PrecipitationSum    []float64 `json:"precipitation_sum"`
+		PrecipitationChance []float64 `json:"precipitation_probability_max"`
+	} `json:"daily"`
+}
+
+// openMeteoPlaces is the payload of the Open-Meteo geocoding API.
+type openMeteoPlaces struct {
+	Results []struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	} `json:"results"`
+}
+
+// ClientOpenMeteo fetches weather data from Open-Meteo, which needs no API key.
+// Open-Meteo only takes coordinates, so city lookups go through its geocoding
+// API first, and it reports WMO codes rather than text, so conditions are
+// described in English whatever language is asked for.
+//
+// A quota counts one call per lookup: the forecast API request. The geocoding
+// request made before it goes to a separate API and is not counted,
+// so an OpenMeteo budget bounds forecast API requests only.
+type ClientOpenMeteo struct {
+	apiURL       string
+	geocodingURL string
+	client       HTTPClient
+	logger       zerolog.Logger
+}
+
+// NewClientOpenMeteo constructs a new Open-Meteo client.
+func NewClientOpenMeteo(apiURL, geocodingURL string, httpClient HTTPClient, logger zerolog.Logger) *ClientOpenMeteo {
+	return &ClientOpenMeteo{
+		apiURL:       apiURL,
+		geocodingURL: geocodingURL,
+		client:       httpClient,
+		logger:       logger,
+	}
+}
+
+// Fetch retrieves weather data for a given city, with structured logging.
+func (s *ClientOpenMeteo) Fetch(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	lat, lon, err := s.locate(ctx, city, lang)
+	if err != nil {
+		return models.WeatherData{}, err
+	}
+	data, err := s.current(ctx, lat, lon, city)
+	if err != nil {
+		return models.WeatherData{}, err
+	}
+	data.City = city
+	return data, nil
+}
+
+// FetchByCoordinates retrieves weather data for the given point. Open-Meteo does
+// not name the place, so the point itself is reported as the city.
+func (s *ClientOpenMeteo) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	location := formatCoordinates(lat, lon)
+	data, err := s.current(ctx, lat, lon, location)
+	if err != nil {
+		return models.WeatherData{}, err
+	}
+	data.City = location
+	return data, nil
+}
+
+// current requests the current weather at a point; location only labels the logs.
+func (s *ClientOpenMeteo) current(ctx context.Context, lat, lon float64, location string) (models.WeatherData, error) {
+	start := time.Now()
+	u := fmt.Sprintf("%s?latitude=%s&longitude=%s&current=%s&wind_speed_unit=ms&timeformat=unixtime",
+		s.apiURL, formatCoordinate(lat), formatCoordinate(lon), openMeteoCurrentFields)
+
+	var raw openMeteoCurrent
+	if err := s.get(ctx, u, location, &raw); err != nil {
+		return models.WeatherData{}, err
+	}
+
+	condition, code := wmoCondition(raw.Current.WeatherCode)
+	data := models.WeatherData{
+		Temperature:   raw.Current.Temperature,
+		Condition:     condition,
+		ConditionCode: code,
+		Humidity:      raw.Current.Humidity,
+		WindSpeed:     raw.Current.WindSpeed,
+		WindDirection: raw.Current.WindDirection,
+		Pressure:      raw.Current.Pressure,
+		FeelsLike:     raw.Current.FeelsLike,
+		ObservedAt:    observedAt(raw.Current.Time),
+		Provider:      providerOpenMeteo,
+	}
+
+	s.logger.Info().
+		Ctx(ctx).
+		Str("location", location).
+		Dur("duration_ms", time.Since(start)).
+		Msg("successfully fetched weather data from Open-Meteo")
+
+	return data, nil
+}
+
+// FetchForecast retrieves a daily forecast for a given city.
+func (s *ClientOpenMeteo) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	start := time.Now()
+
+	lat, lon, err := s.locate(ctx, city, "")
+	if err != nil {
+		return models.Forecast{}, err
+	}
+
+	u := fmt.Sprintf("%s?latitude=%s&longitude=%s&daily=%s&forecast_days=%d&timezone=auto",
+		s.apiURL, formatCoordinate(lat), formatCoordinate(lon), openMeteoDailyFields, days)
+
+	var raw openMeteoDaily
+	if err := s.get(ctx, u, city, &raw); err != nil {
+		return models.Forecast{}, err
+	}
+
+	daily := raw.Daily
+	n := len(daily.Time)
+	if n == 0 || len(daily.WeatherCode) < n || len(daily.TemperatureMax) < n || len(daily.TemperatureMin) < n {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("city", city).
+			Msg("no complete days in Open-Meteo forecast response")
+		return models.Forecast{}, invalidResponse(providerOpenMeteo, errEmptyPayload)
+	}
+
+	forecast := models.Forecast{City: city, Days: make([]models.DailyForecast, 0, n)}
+	for i, date := range daily.Time {
+		condition, code := wmoCondition(daily.WeatherCode[i])
+		day := models.DailyForecast{
+			Date:           date,
+			MinTemperature: daily.TemperatureMin[i],
+			MaxTemperature: daily.TemperatureMax[i],
+			Condition:      condition,
+			ConditionCode:  code,
+		}
+		if i < len(daily.PrecipitationChance) {
+			day.PrecipitationChance = daily.PrecipitationChance[i]
+		}
+		if i < len(daily.PrecipitationSum) {
+			day.PrecipitationMM = daily.PrecipitationSum[i]
+		}
+		forecast.Days = append(forecast.Days, day)
+	}
+
+	s.logger.Info().
+		Ctx(ctx).
+		Str("city", city).
+		Int("days", len(forecast.Days)).
+		Dur("duration_ms", time.Since(start)).
+		Msg("successfully fetched forecast data from Open-Meteo")
+
+	return forecast, nil
+}
+
+// locate resolves a city to coordinates with the geocoding API. A city it does
+// not know is reported as not found.
+func (s *ClientOpenMeteo) locate(ctx context.Context, city, lang string) (float64, float64, error) {
+	u := fmt.Sprintf("%s?name=%s&count=1&format=json", s.geocodingURL, url.QueryEscape(city))
+	if lang != "" {
+		u += "&language=" + lang
+	}
+
+	var raw openMeteoPlaces
+	if err := s.get(ctx, u, city, &raw); err != nil {
+		return 0, 0, err
+	}
+	if len(raw.Results) == 0 {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("city", city).
+			Msg("Open-Meteo geocoding found no such city")
+		return 0, 0, models.NewProviderError(providerOpenMeteo, models.ErrCityNotFound, errEmptyPayload)
+	}
+	return raw.Results[0].Latitude, raw.Results[0].Longitude, nil
+}
+
+// get requests u and decodes the JSON response into out; location only
+// labels the logs. Every Open-Meteo lookup takes two requests, so they share
+// this rather than repeating it.
+func (s *ClientOpenMeteo) get(ctx context.Context, u, location string, out any) error {
+	s.logger.Debug().
+		Ctx(ctx).
+		Str("location", location).
+		Str("url", u).
+		Msg("starting Open-Meteo request")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("location", location).
+			Str("url", u).
+			Msg("failed to create HTTP request")
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("location", location).
+			Str("url", u).
+			Msg("error sending HTTP request to Open-Meteo")
+		return transportError(providerOpenMeteo, err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			s.logger.Error().
+				Err(cerr).
+				Ctx(ctx).
+				Str("location", location).
+				Msg("failed to close response body")
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("location", location).
+			Str("status", resp.Status).
+			Msg("Open-Meteo returned non-200 status")
+		return statusError(providerOpenMeteo, resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("location", location).
+			Msg("failed to decode Open-Meteo response")
+		return invalidResponse(providerOpenMeteo, err)
+	}
+	return nil
+}
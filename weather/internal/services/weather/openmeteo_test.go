@@ -0,0 +1,189 @@
+//go:build unit
+
+package weather_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather"
+)
+
+const (
+	openMeteoPlaces = `{"results": [{"name": "Kyiv", "latitude": 50.45, "longitude": 30.52}]}`
+
+	openMeteoCurrent = `{
+	  "current": {
+		"time": 1751371200,
+		"temperature_2m": 18.5,
+		"relative_humidity_2m": 55,
+		"apparent_temperature": 17.9,
+		"weather_code": 61,
+		"pressure_msl": 1004.2,
+		"wind_speed_10m": 3.4,
+		"wind_direction_10m": 270
+	  }
+	}`
+
+	openMeteoDaily = `{
+	  "daily": {
+		"time": ["2025-07-01", "2025-07-02"],
+		"weather_code": [3, 95],
+		"temperature_2m_max": [24.1, 27.3],
+		"temperature_2m_min": [13.2, 16.8],
+		"precipitation_sum": [0, 12.4],
+		"precipitation_probability_max": [5, 80]
+	  }
+	}`
+)
+
+// newOpenMeteoServer serves the geocoding API under /search and the forecast API
+// under /forecast, answering with the given payloads.
+func newOpenMeteoServer(t *testing.T, places, forecast string, forecastStatus int) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Kyiv", r.URL.Query().Get("name"))
+		_, _ = w.Write([]byte(places))
+	})
+	mux.HandleFunc("/forecast", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "50.45", r.URL.Query().Get("latitude"))
+		assert.Equal(t, "30.52", r.URL.Query().Get("longitude"))
+		w.WriteHeader(forecastStatus)
+		_, _ = w.Write([]byte(forecast))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newOpenMeteoClient(t *testing.T, srv *httptest.Server) *weather.ClientOpenMeteo {
+	t.Helper()
+	l, err := logger.NewLogger("", "openmeteo_test")
+	require.NoError(t, err)
+	return weather.NewClientOpenMeteo(srv.URL+"/forecast", srv.URL+"/search", srv.Client(), l)
+}
+
+func Test_OpenMeteo_Fetch_Success(t *testing.T) {
+	srv := newOpenMeteoServer(t, openMeteoPlaces, openMeteoCurrent, http.StatusOK)
+
+	data, err := newOpenMeteoClient(t, srv).Fetch(context.Background(), "Kyiv", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Kyiv", data.City)
+	assert.Equal(t, 18.5, data.Temperature)
+	assert.Equal(t, "Slight rain", data.Condition)
+	assert.Equal(t, models.ConditionRain, data.ConditionCode)
+	assert.Equal(t, 55, data.Humidity)
+	assert.Equal(t, 3.4, data.WindSpeed)
+	assert.Equal(t, 270, data.WindDirection)
+	assert.Equal(t, 1004.2, data.Pressure)
+	assert.Equal(t, 17.9, data.FeelsLike)
+	assert.Equal(t, time.Unix(1751371200, 0).UTC(), data.ObservedAt)
+	assert.Equal(t, "OpenMeteo", data.Provider)
+}
+
+func Test_OpenMeteo_Fetch_CityNotFound(t *testing.T) {
+	srv := newOpenMeteoServer(t, `{"generationtime_ms": 0.5}`, openMeteoCurrent, http.StatusOK)
+
+	data, err := newOpenMeteoClient(t, srv).Fetch(context.Background(), "Kyiv", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
+	assert.Equal(t, models.WeatherData{}, data)
+}
+
+func Test_OpenMeteo_Fetch_APIError(t *testing.T) {
+	srv := newOpenMeteoServer(t, openMeteoPlaces, `{"error": true, "reason": "Internal error"}`,
+		http.StatusServiceUnavailable)
+
+	data, err := newOpenMeteoClient(t, srv).Fetch(context.Background(), "Kyiv", "")
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
+	assert.Equal(t, models.WeatherData{}, data)
+}
+
+func Test_OpenMeteo_Fetch_InvalidJSON(t *testing.T) {
+	srv := newOpenMeteoServer(t, openMeteoPlaces, `{"current": `, http.StatusOK)
+
+	_, err := newOpenMeteoClient(t, srv).Fetch(context.Background(), "Kyiv", "")
+	assert.ErrorIs(t, err, models.ErrInvalidResponse)
+}
+
+func Test_OpenMeteo_FetchByCoordinates(t *testing.T) {
+	srv := newOpenMeteoServer(t, openMeteoPlaces, openMeteoCurrent, http.StatusOK)
+
+	data, err := newOpenMeteoClient(t, srv).FetchByCoordinates(context.Background(), 50.45, 30.52)
+	require.NoError(t, err)
+	assert.Equal(t, "50.45,30.52", data.City)
+	assert.Equal(t, 18.5, data.Temperature)
+}
+
+func Test_OpenMeteo_FetchForecast(t *testing.T) {
+	srv := newOpenMeteoServer(t, openMeteoPlaces, openMeteoDaily, http.StatusOK)
+
+	forecast, err := newOpenMeteoClient(t, srv).FetchForecast(context.Background(), "Kyiv", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Kyiv", forecast.City)
+	assert.Equal(t, []models.DailyForecast{
+		{
+			Date:                "2025-07-01",
+			MinTemperature:      13.2,
+			MaxTemperature:      24.1,
+			Condition:           "Overcast",
+			ConditionCode:       models.ConditionCloudy,
+			PrecipitationChance: 5,
+		},
+		{
+			Date:                "2025-07-02",
+			MinTemperature:      16.8,
+			MaxTemperature:      27.3,
+			Condition:           "Thunderstorm",
+			ConditionCode:       models.ConditionThunderstorm,
+			PrecipitationChance: 80,
+			PrecipitationMM:     12.4,
+		},
+	}, forecast.Days)
+}
+
+func Test_OpenMeteo_FetchForecast_Empty(t *testing.T) {
+	srv := newOpenMeteoServer(t, openMeteoPlaces, `{"daily": {"time": []}}`, http.StatusOK)
+
+	_, err := newOpenMeteoClient(t, srv).FetchForecast(context.Background(), "Kyiv", 2)
+	assert.ErrorIs(t, err, models.ErrInvalidResponse)
+}
+
+func Test_OpenMeteo_RequestedFields(t *testing.T) {
+	var query []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(openMeteoPlaces))
+	})
+	mux.HandleFunc("/forecast", func(w http.ResponseWriter, r *http.Request) {
+		query = append(query, r.URL.RawQuery)
+		if r.URL.Query().Has("daily") {
+			assert.Equal(t, "3", r.URL.Query().Get("forecast_days"))
+			_, _ = w.Write([]byte(openMeteoDaily))
+			return
+		}
+		assert.Contains(t, r.URL.Query().Get("current"), "pressure_msl")
+		_, _ = w.Write([]byte(openMeteoCurrent))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := newOpenMeteoClient(t, srv)
+
+	data, err := client.Fetch(context.Background(), "Kyiv", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1004.2, data.Pressure)
+
+	_, err = client.FetchForecast(context.Background(), "Kyiv", 3)
+	require.NoError(t, err)
+	assert.Len(t, query, 2)
+}
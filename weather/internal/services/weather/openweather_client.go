@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -12,43 +13,107 @@ import (
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
 )
 
+const (
+	// slotsPerDay is the number of 3-hour entries OpenWeatherMap returns per day.
+	slotsPerDay = 8
+	percent     = 100
+
+	providerOpenWeather = "OpenWeather"
+)
+
 type apiResponse struct {
+	Name string `json:"name"`
+	Dt   int64  `json:"dt"`
 	Main struct {
 		Temp      float64 `json:"temp"`
 		FeelsLike float64 `json:"feels_like"`
 		Pressure  int     `json:"pressure"`
 		Humidity  int     `json:"humidity"`
 	} `json:"main"`
+	Wind struct {
+		Speed float64 `json:"speed"`
+		Deg   int     `json:"deg"`
+	} `json:"wind"`
 	Weather []struct {
+		ID          int    `json:"id"`
 		Main        string `json:"main"`
 		Description string `json:"description"`
 	} `json:"weather"`
 }
 
+// forecastAPIResponse is the 5 day / 3 hour forecast payload of OpenWeatherMap.
+type forecastAPIResponse struct {
+	City struct {
+		Name string `json:"name"`
+	} `json:"city"`
+	List []struct {
+		DtTxt string `json:"dt_txt"`
+		Main  struct {
+			TempMin float64 `json:"temp_min"`
+			TempMax float64 `json:"temp_max"`
+		} `json:"main"`
+		Weather []struct {
+			ID   int    `json:"id"`
+			Main string `json:"main"`
+		} `json:"weather"`
+		Pop  float64 `json:"pop"`
+		Rain struct {
+			ThreeHours float64 `json:"3h"`
+		} `json:"rain"`
+		Snow struct {
+			ThreeHours float64 `json:"3h"`
+		} `json:"snow"`
+	} `json:"list"`
+}
+
 // ClientOpenWeatherMap fetches weather data from OpenWeatherMap API.
 type ClientOpenWeatherMap struct {
-	APIKey string
-	apiURL string
-	client HTTPClient
-	logger zerolog.Logger
+	APIKey      string
+	apiURL      string
+	forecastURL string
+	client      HTTPClient
+	logger      zerolog.Logger
 }
 
 // NewClientOpenWeatherMap constructs a new OpenWeatherMap client.
-func NewClientOpenWeatherMap(apiKey, apiURL string,
+func NewClientOpenWeatherMap(apiKey, apiURL, forecastURL string,
 	httpClient HTTPClient, logger zerolog.Logger,
 ) *ClientOpenWeatherMap {
-	return &ClientOpenWeatherMap{APIKey: apiKey, apiURL: apiURL, client: httpClient, logger: logger}
+	return &ClientOpenWeatherMap{
+		APIKey:      apiKey,
+		apiURL:      apiURL,
+		forecastURL: forecastURL,
+		client:      httpClient,
+		logger:      logger,
+	}
 }
 
 // Fetch retrieves weather data for a given city, with structured logging.
-func (s *ClientOpenWeatherMap) Fetch(ctx context.Context, city string) (models.WeatherData, error) {
+func (s *ClientOpenWeatherMap) Fetch(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	url := fmt.Sprintf("%s?q=%s&appid=%s&units=metric%s", s.apiURL, city, s.APIKey, langParam(lang))
+	data, err := s.current(ctx, url, city)
+	if err != nil {
+		return models.WeatherData{}, err
+	}
+	data.City = city
+	return data, nil
+}
+
+// FetchByCoordinates retrieves weather data for the given point. The city is the
+// one OpenWeatherMap places the point in.
+func (s *ClientOpenWeatherMap) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	url := fmt.Sprintf("%s?lat=%s&lon=%s&appid=%s&units=metric",
+		s.apiURL, formatCoordinate(lat), formatCoordinate(lon), s.APIKey)
+	return s.current(ctx, url, formatCoordinates(lat, lon))
+}
+
+// current requests the current weather at url; location only labels the logs.
+func (s *ClientOpenWeatherMap) current(ctx context.Context, url, location string) (models.WeatherData, error) {
 	start := time.Now()
-	// Build URL
-	url := fmt.Sprintf("%s?q=%s&appid=%s&units=metric", s.apiURL, city, s.APIKey)
 
 	s.logger.Debug().
 		Ctx(ctx).
-		Str("city", city).
+		Str("location", location).
 		Str("url", url).
 		Msg("starting OpenWeatherMap request")
 
@@ -57,7 +122,7 @@ func (s *ClientOpenWeatherMap) Fetch(ctx context.Context, city string) (models.W
 		s.logger.Error().
 			Err(err).
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Str("url", url).
 			Msg("failed to create HTTP request")
 		return models.WeatherData{}, err
@@ -68,17 +133,17 @@ func (s *ClientOpenWeatherMap) Fetch(ctx context.Context, city string) (models.W
 		s.logger.Error().
 			Err(err).
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Str("url", url).
 			Msg("error sending HTTP request to OpenWeatherMap")
-		return models.WeatherData{}, err
+		return models.WeatherData{}, transportError(providerOpenWeather, err)
 	}
 	defer func() {
 		if cerr := resp.Body.Close(); cerr != nil {
 			s.logger.Error().
 				Err(cerr).
 				Ctx(ctx).
-				Str("city", city).
+				Str("location", location).
 				Msg("failed to close response body")
 		}
 	}()
@@ -86,10 +151,10 @@ func (s *ClientOpenWeatherMap) Fetch(ctx context.Context, city string) (models.W
 	if resp.StatusCode != http.StatusOK {
 		s.logger.Error().
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Str("status", resp.Status).
 			Msg("OpenWeatherMap API returned non-200 status")
-		return models.WeatherData{}, fmt.Errorf("OpenWeatherAPI error: status %s", resp.Status)
+		return models.WeatherData{}, statusError(providerOpenWeather, resp)
 	}
 
 	var raw apiResponse
@@ -97,23 +162,170 @@ func (s *ClientOpenWeatherMap) Fetch(ctx context.Context, city string) (models.W
 		s.logger.Error().
 			Err(err).
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Msg("failed to decode OpenWeatherMap response")
-		return models.WeatherData{}, err
+		return models.WeatherData{}, invalidResponse(providerOpenWeather, err)
+	}
+
+	if len(raw.Weather) == 0 {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("location", location).
+			Msg("no weather conditions in OpenWeatherMap response")
+		return models.WeatherData{}, invalidResponse(providerOpenWeather, errEmptyPayload)
 	}
 
 	data := models.WeatherData{
-		City:        city,
-		Temperature: raw.Main.Temp,
-		Condition:   raw.Weather[0].Main,
+		City:          raw.Name,
+		Temperature:   raw.Main.Temp,
+		Condition:     raw.Weather[0].Main,
+		ConditionCode: openWeatherCondition(raw.Weather[0].ID),
+		Humidity:      raw.Main.Humidity,
+		WindSpeed:     raw.Wind.Speed,
+		WindDirection: raw.Wind.Deg,
+		Pressure:      float64(raw.Main.Pressure),
+		FeelsLike:     raw.Main.FeelsLike,
+		ObservedAt:    observedAt(raw.Dt),
+		Provider:      providerOpenWeather,
 	}
 
 	duration := time.Since(start)
 	s.logger.Info().
 		Ctx(ctx).
-		Str("city", city).
+		Str("location", location).
 		Dur("duration_ms", duration).
 		Msg("successfully fetched weather data")
 
 	return data, nil
 }
+
+// FetchForecast retrieves the 3-hourly forecast for a given city and folds it into
+// daily entries. The slots start at the current hour, so they usually spill into
+// one more calendar day than asked for; that partial day is dropped.
+func (s *ClientOpenWeatherMap) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	start := time.Now()
+	url := fmt.Sprintf("%s?q=%s&appid=%s&units=metric&cnt=%d", s.forecastURL, city, s.APIKey, days*slotsPerDay)
+
+	s.logger.Debug().
+		Ctx(ctx).
+		Str("city", city).
+		Int("days", days).
+		Str("url", url).
+		Msg("starting OpenWeatherMap forecast request")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("city", city).
+			Str("url", url).
+			Msg("failed to create HTTP request")
+		return models.Forecast{}, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("city", city).
+			Str("url", url).
+			Msg("error sending forecast request to OpenWeatherMap")
+		return models.Forecast{}, transportError(providerOpenWeather, err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			s.logger.Error().
+				Err(cerr).
+				Ctx(ctx).
+				Str("city", city).
+				Msg("failed to close response body")
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("city", city).
+			Str("status", resp.Status).
+			Msg("OpenWeatherMap forecast returned non-200 status")
+		return models.Forecast{}, statusError(providerOpenWeather, resp)
+	}
+
+	var raw forecastAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("city", city).
+			Msg("failed to decode OpenWeatherMap forecast response")
+		return models.Forecast{}, invalidResponse(providerOpenWeather, err)
+	}
+
+	if len(raw.List) == 0 {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("city", city).
+			Msg("no entries in OpenWeatherMap forecast response")
+		return models.Forecast{}, invalidResponse(providerOpenWeather, errEmptyPayload)
+	}
+
+	name := raw.City.Name
+	if name == "" {
+		name = city
+	}
+	forecastDays := foldSlots(raw)
+	if len(forecastDays) > days {
+		forecastDays = forecastDays[:days]
+	}
+	forecast := models.Forecast{City: name, Days: forecastDays}
+
+	s.logger.Info().
+		Ctx(ctx).
+		Str("city", city).
+		Int("days", len(forecast.Days)).
+		Dur("duration_ms", time.Since(start)).
+		Msg("successfully fetched forecast data")
+
+	return forecast, nil
+}
+
+// foldSlots aggregates 3-hour forecast slots into daily min/max, the most frequent
+// condition, the highest precipitation probability and the total precipitation.
+func foldSlots(raw forecastAPIResponse) []models.DailyForecast {
+	var days []models.DailyForecast
+	conditions := map[string]map[string]int{}
+
+	for _, slot := range raw.List {
+		date, _, _ := strings.Cut(slot.DtTxt, " ")
+		condition, code := "", models.ConditionUnknown
+		if len(slot.Weather) > 0 {
+			condition, code = slot.Weather[0].Main, openWeatherCondition(slot.Weather[0].ID)
+		}
+
+		if len(days) == 0 || days[len(days)-1].Date != date {
+			days = append(days, models.DailyForecast{
+				Date:           date,
+				MinTemperature: slot.Main.TempMin,
+				MaxTemperature: slot.Main.TempMax,
+				Condition:      condition,
+				ConditionCode:  code,
+			})
+			conditions[date] = map[string]int{}
+		}
+
+		day := &days[len(days)-1]
+		day.MinTemperature = min(day.MinTemperature, slot.Main.TempMin)
+		day.MaxTemperature = max(day.MaxTemperature, slot.Main.TempMax)
+		day.PrecipitationChance = max(day.PrecipitationChance, slot.Pop*percent)
+		day.PrecipitationMM += slot.Rain.ThreeHours + slot.Snow.ThreeHours
+
+		conditions[date][condition]++
+		if conditions[date][condition] > conditions[date][day.Condition] {
+			day.Condition, day.ConditionCode = condition, code
+		}
+	}
+
+	return days
+}
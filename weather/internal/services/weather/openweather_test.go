@@ -37,6 +37,7 @@ func Test_OpenWeather_GetByCity_Success(t *testing.T) {
 				  },
 				  "weather": [
 					{
+					  "id": 800,
 					  "main": "Sunny",
 					  "description": "Cool"
 					}
@@ -53,13 +54,18 @@ func Test_OpenWeather_GetByCity_Success(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to create logger: %v", err)
 	}
-	weatherAPIClient := weather.NewClientOpenWeatherMap("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientOpenWeatherMap("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "London")
+	data, err := weatherAPIClient.Fetch(ctx, "London", "")
 	assert.NoError(t, err)
 	assert.Equal(t, "London", data.City)
 	assert.Equal(t, 15.0, data.Temperature)
 	assert.Equal(t, "Sunny", data.Condition)
+	assert.Equal(t, models.ConditionClear, data.ConditionCode)
+	assert.Equal(t, 60, data.Humidity)
+	assert.Equal(t, 1013.0, data.Pressure)
+	assert.Equal(t, 24.0, data.FeelsLike)
+	assert.Equal(t, "OpenWeather", data.Provider)
 }
 
 func Test_OpenWeatherGetByCity_CityNotFound(t *testing.T) {
@@ -80,10 +86,10 @@ func Test_OpenWeatherGetByCity_CityNotFound(t *testing.T) {
 	l, err := logger.NewLogger("", "openweather_test_city_not_found")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "UnknownCity")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "UnknownCity", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
 	assert.Equal(t, models.WeatherData{}, data)
 }
 
@@ -105,10 +111,10 @@ func Test_OpenWeatherGetByCity_APIError(t *testing.T) {
 	l, err := logger.NewLogger("", "openweather_test_api_error")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "London")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "London", "")
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
 	assert.Equal(t, models.WeatherData{}, data)
 }
 
@@ -130,9 +136,134 @@ func Test_OpenWeatherGetByCity_InvalidAPIKey(t *testing.T) {
 	l, err := logger.NewLogger("", "openweather_test_invalid_api_key")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "London")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "London", "")
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
 	assert.Equal(t, models.WeatherData{}, data)
 }
+
+func Test_OpenWeather_FetchForecast_FoldsSlotsIntoDays(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	m := &mockHTTPClient{}
+
+	m.On("Do", mock.Anything).Return(
+		&http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(
+				`{
+				  "city": {"name": "London"},
+				  "list": [
+					{"dt_txt": "2025-07-01 09:00:00", "main": {"temp_min": 12.0, "temp_max": 15.0},
+					 "weather": [{"id": 803, "main": "Clouds"}], "pop": 0.2},
+					{"dt_txt": "2025-07-01 12:00:00", "main": {"temp_min": 14.0, "temp_max": 19.5},
+					 "weather": [{"id": 500, "main": "Rain"}], "pop": 0.7, "rain": {"3h": 1.5}},
+					{"dt_txt": "2025-07-01 15:00:00", "main": {"temp_min": 15.0, "temp_max": 18.0},
+					 "weather": [{"id": 500, "main": "Rain"}], "pop": 0.5, "rain": {"3h": 0.5}},
+					{"dt_txt": "2025-07-02 00:00:00", "main": {"temp_min": 9.0, "temp_max": 11.0},
+					 "weather": [{"id": 800, "main": "Clear"}], "pop": 0}
+				  ]
+				}`)),
+		}, nil).Once()
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("", "openweather_test_forecast")
+	require.NoError(t, err)
+
+	weatherAPIClient := weather.NewClientOpenWeatherMap("1234567890", "", "", m, l)
+
+	forecast, err := weatherAPIClient.FetchForecast(ctx, "London", 2)
+	require.NoError(t, err)
+	require.Len(t, forecast.Days, 2)
+
+	assert.Equal(t, "London", forecast.City)
+	assert.Equal(t, models.DailyForecast{
+		Date:                "2025-07-01",
+		MinTemperature:      12.0,
+		MaxTemperature:      19.5,
+		Condition:           "Rain",
+		ConditionCode:       models.ConditionRain,
+		PrecipitationChance: 70,
+		PrecipitationMM:     2.0,
+	}, forecast.Days[0])
+	assert.Equal(t, "2025-07-02", forecast.Days[1].Date)
+	assert.Equal(t, "Clear", forecast.Days[1].Condition)
+	assert.Equal(t, models.ConditionClear, forecast.Days[1].ConditionCode)
+}
+
+func Test_OpenWeather_FetchForecast_TrimsToDaysAndUsesProviderName(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	m := &mockHTTPClient{}
+
+	m.On("Do", mock.Anything).Return(
+		&http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(
+				`{
+				  "city": {"name": "London"},
+				  "list": [
+					{"dt_txt": "2025-07-01 21:00:00", "main": {"temp_min": 12.0, "temp_max": 15.0},
+					 "weather": [{"id": 800, "main": "Clear"}]},
+					{"dt_txt": "2025-07-02 00:00:00", "main": {"temp_min": 9.0, "temp_max": 11.0},
+					 "weather": [{"id": 800, "main": "Clear"}]},
+					{"dt_txt": "2025-07-02 21:00:00", "main": {"temp_min": 10.0, "temp_max": 13.0},
+					 "weather": [{"id": 800, "main": "Clear"}]},
+					{"dt_txt": "2025-07-03 00:00:00", "main": {"temp_min": 8.0, "temp_max": 10.0},
+					 "weather": [{"id": 800, "main": "Clear"}]}
+				  ]
+				}`)),
+		}, nil).Once()
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("", "openweather_test_forecast_trim")
+	require.NoError(t, err)
+
+	weatherAPIClient := weather.NewClientOpenWeatherMap("1234567890", "", "", m, l)
+
+	forecast, err := weatherAPIClient.FetchForecast(ctx, "london ", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, "London", forecast.City)
+	require.Len(t, forecast.Days, 2)
+	assert.Equal(t, "2025-07-01", forecast.Days[0].Date)
+	assert.Equal(t, "2025-07-02", forecast.Days[1].Date)
+}
+
+func Test_OpenWeather_FetchByCoordinates(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	m := &mockHTTPClient{}
+
+	m.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		q := req.URL.Query()
+		return q.Get("lat") == "49.84" && q.Get("lon") == "24.03" && q.Get("q") == ""
+	})).Return(
+		&http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(
+				`{"name": "Lviv", "main": {"temp": 21.5, "humidity": 48}, "weather": [{"id": 800, "main": "Clear"}]}`)),
+		}, nil).Once()
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("", "openweather_test_coordinates")
+	require.NoError(t, err)
+
+	weatherAPIClient := weather.NewClientOpenWeatherMap("1234567890", "", "", m, l)
+
+	data, err := weatherAPIClient.FetchByCoordinates(ctx, 49.84, 24.03)
+	require.NoError(t, err)
+	assert.Equal(t, "Lviv", data.City)
+	assert.Equal(t, 21.5, data.Temperature)
+	assert.Equal(t, "OpenWeather", data.Provider)
+}
@@ -0,0 +1,188 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+const (
+	windowMinute = "minute"
+	windowDay    = "day"
+)
+
+// QuotaConfig budgets the calls made to one provider. A zero budget is unlimited.
+type QuotaConfig struct {
+	PerMinute int64
+	PerDay    int64
+	// Cost is what a single call costs, in whatever unit the operator bills in.
+	Cost float64
+
+	// OnRemaining, when set, is told the calls left in a window after every reservation.
+	OnRemaining func(provider, window string, left int64)
+	// OnCall, when set, is told about every call let through to the provider, with its cost.
+	OnCall func(provider string, cost float64)
+}
+
+// quotaCounter counts calls against shared budgets.
+type quotaCounter interface {
+	// Reserve counts one call by scope at now against every window, unless one
+	// of them is spent, and returns the calls left in each window.
+	Reserve(ctx context.Context, scope string, windows []models.QuotaWindow, now time.Time) ([]int64, bool, error)
+}
+
+// QuotaClient wraps another weather client with per-minute and per-day call
+// budgets. Calls over budget are rejected without reaching the provider, and
+// the client reports itself exhausted until the spent window ends so that
+// ServiceProvider skips it. It belongs inside the retries, so that every call
+// the provider sees is charged; a rejection is not retried, and the breaker
+// does not count it, as a spent budget says nothing about the provider's health.
+type QuotaClient struct {
+	name    string
+	cfg     QuotaConfig
+	windows []models.QuotaWindow
+	counter quotaCounter
+	wrapped Client
+	logger  zerolog.Logger
+	now     func() time.Time
+
+	mu             sync.Mutex
+	exhaustedUntil time.Time
+}
+
+func NewQuotaClient(
+	name string,
+	cfg QuotaConfig,
+	counter quotaCounter,
+	logger zerolog.Logger,
+	wrapped Client,
+) *QuotaClient {
+	var windows []models.QuotaWindow
+	if cfg.PerMinute > 0 {
+		windows = append(windows, models.QuotaWindow{Name: windowMinute, Period: time.Minute, Limit: cfg.PerMinute})
+	}
+	if cfg.PerDay > 0 {
+		windows = append(windows, models.QuotaWindow{Name: windowDay, Period: 24 * time.Hour, Limit: cfg.PerDay})
+	}
+	return &QuotaClient{
+		name:    name,
+		cfg:     cfg,
+		windows: windows,
+		counter: counter,
+		wrapped: wrapped,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Name returns the provider name the client was created with.
+func (q *QuotaClient) Name() string {
+	return q.name
+}
+
+// Exhausted reports whether the budget was spent when last checked. It is known
+// locally, from this replica's last reservation, so it costs no Redis call.
+func (q *QuotaClient) Exhausted() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.now().Before(q.exhaustedUntil)
+}
+
+// Fetch reserves a call and runs the wrapped client's Fetch.
+func (q *QuotaClient) Fetch(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	if err := q.reserve(ctx); err != nil {
+		return models.WeatherData{}, err
+	}
+	data, err := q.wrapped.Fetch(ctx, city, lang)
+	q.observe(err)
+	return data, err
+}
+
+// FetchByCoordinates reserves a call and runs the wrapped client's FetchByCoordinates.
+func (q *QuotaClient) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	if err := q.reserve(ctx); err != nil {
+		return models.WeatherData{}, err
+	}
+	data, err := q.wrapped.FetchByCoordinates(ctx, lat, lon)
+	q.observe(err)
+	return data, err
+}
+
+// FetchForecast reserves a call and runs the wrapped client's FetchForecast.
+func (q *QuotaClient) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	if err := q.reserve(ctx); err != nil {
+		return models.Forecast{}, err
+	}
+	forecast, err := q.wrapped.FetchForecast(ctx, city, days)
+	q.observe(err)
+	return forecast, err
+}
+
+// reserve counts a call against the budget. When Redis cannot be reached the
+// call is let through: the provider enforces its own limits anyway, and losing
+// the weather over a bookkeeping failure would be worse.
+func (q *QuotaClient) reserve(ctx context.Context) error {
+	if len(q.windows) == 0 {
+		q.charge()
+		return nil
+	}
+
+	now := q.now()
+	left, ok, err := q.counter.Reserve(ctx, q.name, q.windows, now)
+	if err != nil {
+		q.logger.Warn().
+			Ctx(ctx).
+			Str("provider", q.name).
+			Err(err).
+			Msg("quota: failed to reserve call, letting it through")
+		q.charge()
+		return nil
+	}
+
+	for i, w := range q.windows {
+		if q.cfg.OnRemaining != nil {
+			q.cfg.OnRemaining(q.name, w.Name, left[i])
+		}
+		if left[i] <= 0 {
+			q.exhaustUntil(w.End(now))
+		}
+	}
+
+	if !ok {
+		q.logger.Warn().
+			Ctx(ctx).
+			Str("provider", q.name).
+			Msg("quota: budget spent, call rejected")
+		return quotaError(q)
+	}
+	q.charge()
+	return nil
+}
+
+// observe marks the client exhausted for the rest of the minute when the
+// provider itself says its quota is spent, whatever the configured budget.
+func (q *QuotaClient) observe(err error) {
+	if errors.Is(err, models.ErrQuotaExceeded) {
+		now := q.now()
+		q.exhaustUntil(now.Truncate(time.Minute).Add(time.Minute))
+	}
+}
+
+func (q *QuotaClient) charge() {
+	if q.cfg.OnCall != nil {
+		q.cfg.OnCall(q.name, q.cfg.Cost)
+	}
+}
+
+func (q *QuotaClient) exhaustUntil(t time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if t.After(q.exhaustedUntil) {
+		q.exhaustedUntil = t
+	}
+}
@@ -0,0 +1,162 @@
+//go:build unit
+
+package weather_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/weather"
+)
+
+// memoryQuota counts calls in memory the way RedisQuota does in Redis.
+type memoryQuota struct {
+	mu     sync.Mutex
+	counts map[string]int64
+	err    error
+}
+
+func (q *memoryQuota) Reserve(
+	_ context.Context,
+	scope string,
+	windows []models.QuotaWindow,
+	now time.Time,
+) ([]int64, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.err != nil {
+		return nil, false, q.err
+	}
+	if q.counts == nil {
+		q.counts = map[string]int64{}
+	}
+
+	key := func(w models.QuotaWindow) string {
+		return scope + ":" + w.Name + ":" + w.Start(now).String()
+	}
+	allowed := true
+	for _, w := range windows {
+		if q.counts[key(w)] >= w.Limit {
+			allowed = false
+		}
+	}
+	left := make([]int64, len(windows))
+	for i, w := range windows {
+		if allowed {
+			q.counts[key(w)]++
+		}
+		left[i] = max(w.Limit-q.counts[key(w)], 0)
+	}
+	return left, allowed, nil
+}
+
+func TestQuotaClient_RejectsOverBudget(t *testing.T) {
+	l, err := logger.NewLogger("", "weather_test_quota")
+	require.NoError(t, err)
+
+	wrapped := &mockWrapped{}
+	wrapped.On("Fetch", mock.Anything, city).Return(models.WeatherData{City: city}, nil).Twice()
+
+	remaining := map[string]int64{}
+	var cost float64
+	cfg := weather.QuotaConfig{
+		PerMinute: 5,
+		PerDay:    2,
+		Cost:      0.5,
+		OnRemaining: func(_, window string, left int64) {
+			remaining[window] = left
+		},
+		OnCall: func(_ string, c float64) { cost += c },
+	}
+	client := weather.NewQuotaClient(breakerName, cfg, &memoryQuota{}, l, wrapped)
+
+	for range 2 {
+		_, err := client.Fetch(context.Background(), city, "")
+		require.NoError(t, err)
+	}
+	assert.True(t, client.Exhausted(), "the last call in the day spends the budget")
+
+	_, err = client.Fetch(context.Background(), city, "")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+
+	wrapped.AssertExpectations(t)
+	assert.Contains(t, remaining, "minute")
+	assert.Zero(t, remaining["day"])
+	assert.InDelta(t, 1.0, cost, 0.001)
+}
+
+func TestQuotaClient_FailsOpen(t *testing.T) {
+	l, err := logger.NewLogger("", "weather_test_quota_fail_open")
+	require.NoError(t, err)
+
+	wrapped := &mockWrapped{}
+	wrapped.On("FetchForecast", mock.Anything, city, 3).Return(models.Forecast{City: city}, nil).Once()
+
+	counter := &memoryQuota{err: errors.New("redis: connection refused")}
+	client := weather.NewQuotaClient(breakerName, weather.QuotaConfig{PerMinute: 1}, counter, l, wrapped)
+
+	forecast, err := client.FetchForecast(context.Background(), city, 3)
+	require.NoError(t, err)
+	assert.Equal(t, city, forecast.City)
+	assert.False(t, client.Exhausted())
+	wrapped.AssertExpectations(t)
+}
+
+func TestQuotaClient_ProviderQuotaExhausts(t *testing.T) {
+	l, err := logger.NewLogger("", "weather_test_quota_provider")
+	require.NoError(t, err)
+
+	wrapped := &mockWrapped{}
+	wrapped.On("Fetch", mock.Anything, city).
+		Return(models.WeatherData{}, models.NewProviderError(breakerName, models.ErrQuotaExceeded, errors.New("status 429"))).
+		Once()
+
+	client := weather.NewQuotaClient(breakerName, weather.QuotaConfig{}, &memoryQuota{}, l, wrapped)
+	assert.Equal(t, breakerName, client.Name())
+
+	_, err = client.Fetch(context.Background(), city, "")
+	assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+	assert.True(t, client.Exhausted(), "a 429 from the provider counts as a spent budget")
+}
+
+func TestQuotaClient_ChargesEveryRetry(t *testing.T) {
+	l, err := logger.NewLogger("", "weather_test_quota_retry")
+	require.NoError(t, err)
+
+	wrapped := &mockWrapped{}
+	wrapped.On("Fetch", mock.Anything, city).
+		Return(models.WeatherData{}, models.NewProviderError(breakerName, models.ErrProviderUnavailable, errors.New("connection reset"))).
+		Times(4)
+
+	calls := 0
+	quota := weather.NewQuotaClient(breakerName, weather.QuotaConfig{
+		PerDay: 4,
+		OnCall: func(string, float64) { calls++ },
+	}, &memoryQuota{}, l, wrapped)
+	retryCfg := weather.RetryConfig{Attempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	client := weather.NewBreakerClient(breakerName, breakerCfg, l, weather.NewRetryClient(breakerName, retryCfg, l, quota))
+
+	_, err = client.Fetch(context.Background(), city, "")
+	require.Error(t, err)
+	assert.Equal(t, 3, calls, "every attempt is charged")
+
+	// One call is left: the first attempt spends it, the second is refused and
+	// not retried, and the refusal does not count against the breaker.
+	_, err = client.Fetch(context.Background(), city, "")
+	assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+	assert.Equal(t, 4, calls)
+	assert.True(t, client.Exhausted())
+	assert.Equal(t, uint32(1), client.Status().TotalFailures)
+	assert.Equal(t, uint32(0), client.Status().ConsecutiveFailures)
+	wrapped.AssertExpectations(t)
+}
@@ -0,0 +1,107 @@
+package weather
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+const (
+	defaultWeight = 1.0
+	// unknownLatency is assumed for clients without enough latency samples, so an
+	// untried client ranks below a proven fast one but above a proven slow one.
+	unknownLatency = 500 * time.Millisecond
+)
+
+// order returns client indexes from the most to the least preferred. Clients
+// with equal scores keep their configured order, so until enough calls have been
+// observed the chain is the one given to NewService, reordered only by weights.
+func (s *ServiceProvider) order() []int {
+	scores := make([]float64, len(s.clients))
+	order := make([]int, len(s.clients))
+	for i := range s.clients {
+		scores[i] = s.score(i)
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(scores[b], scores[a])
+	})
+	return order
+}
+
+// candidates returns the clients worth trying in ranked order. Clients with a
+// spent budget are skipped, and a quota error is returned for each of them so
+// that a lookup with nothing left to try reports why.
+func (s *ServiceProvider) candidates() ([]int, []error) {
+	order := s.order()
+	usable := order[:0]
+	errs := make([]error, 0, len(s.clients))
+	for _, i := range order {
+		if s.exhausted(i) {
+			errs = append(errs, quotaError(s.clients[i]))
+			continue
+		}
+		usable = append(usable, i)
+	}
+	return usable, errs
+}
+
+// exhausted reports whether the i-th client has spent its call budget.
+func (s *ServiceProvider) exhausted(i int) bool {
+	return exhausted(s.clients[i])
+}
+
+// score rates a client by its weight, recent success rate and median latency.
+// A client whose breaker is open scores zero and is only tried as a last resort;
+// one whose budget is spent scores zero as well and is not tried at all.
+func (s *ServiceProvider) score(i int) float64 {
+	if r, ok := s.clients[i].(statusReporter); ok && r.Status().State == breakerOpen {
+		return 0
+	}
+	if s.exhausted(i) {
+		return 0
+	}
+
+	score := s.weight(i)
+	if rate, samples := s.stats[i].successRate(); samples >= minSamples {
+		score *= rate
+	}
+	latency, ok := s.stats[i].latency(rankingQuantile)
+	if !ok {
+		latency = unknownLatency
+	}
+	return score / (1 + latency.Seconds())
+}
+
+func (s *ServiceProvider) weight(i int) float64 {
+	if w, ok := s.cfg.Weights[clientName(s.clients[i])]; ok {
+		return w
+	}
+	return defaultWeight
+}
+
+// ProviderStatus reports every client in the order it is currently tried, with
+// its breaker status when it tracks one and the stats the order is based on.
+func (s *ServiceProvider) ProviderStatus() []models.ProviderStatus {
+	order := s.order()
+	statuses := make([]models.ProviderStatus, 0, len(order))
+	for priority, i := range order {
+		status := models.ProviderStatus{Name: clientName(s.clients[i])}
+		if r, ok := s.clients[i].(statusReporter); ok {
+			status = r.Status()
+		}
+
+		status.QuotaExhausted = s.exhausted(i)
+		status.Priority = priority + 1
+		status.Weight = s.weight(i)
+		status.Score = s.score(i)
+		status.SuccessRate, status.Samples = s.stats[i].successRate()
+		if latency, ok := s.stats[i].latency(rankingQuantile); ok {
+			status.LatencyP50Ms = latency.Milliseconds()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
@@ -0,0 +1,158 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+const (
+	defaultRetryBaseDelay = 100 * time.Millisecond
+	defaultRetryMaxDelay  = 2 * time.Second
+)
+
+// RetryConfig configures how one provider's calls are retried.
+type RetryConfig struct {
+	// Attempts is the most calls made per lookup, the first included; below 2 nothing is retried.
+	Attempts int
+	// BaseDelay is the backoff before the first retry; it doubles on every retry after that.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff. A Retry-After longer than this is not waited for.
+	MaxDelay time.Duration
+}
+
+// RetryClient wraps another weather client and retries its calls on transient
+// failures: transport errors, 5xx responses and 429 responses that say when to
+// come back. Backoff is exponential with full jitter and never runs past the
+// caller's deadline. All calls are GETs, so retrying them is safe.
+//
+// It belongs inside the breaker: the breaker then sees one failure per lookup
+// rather than one per attempt, and an open breaker stops retries altogether.
+type RetryClient struct {
+	name    string
+	cfg     RetryConfig
+	wrapped Client
+	logger  zerolog.Logger
+}
+
+func NewRetryClient(name string, cfg RetryConfig, logger zerolog.Logger, wrapped Client) *RetryClient {
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = defaultRetryBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = defaultRetryMaxDelay
+	}
+	return &RetryClient{name: name, cfg: cfg, wrapped: wrapped, logger: logger}
+}
+
+// Name returns the provider name the client was created with.
+func (r *RetryClient) Name() string {
+	return r.name
+}
+
+// Exhausted reports whether the wrapped client has spent its call budget.
+func (r *RetryClient) Exhausted() bool {
+	return exhausted(r.wrapped)
+}
+
+// Fetch runs the wrapped client's Fetch, retrying transient failures.
+func (r *RetryClient) Fetch(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	var data models.WeatherData
+	err := r.do(ctx, func() error {
+		var err error
+		data, err = r.wrapped.Fetch(ctx, city, lang)
+		return err
+	})
+	return data, err
+}
+
+// FetchByCoordinates runs the wrapped client's FetchByCoordinates, retrying transient failures.
+func (r *RetryClient) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	var data models.WeatherData
+	err := r.do(ctx, func() error {
+		var err error
+		data, err = r.wrapped.FetchByCoordinates(ctx, lat, lon)
+		return err
+	})
+	return data, err
+}
+
+// FetchForecast runs the wrapped client's FetchForecast, retrying transient failures.
+func (r *RetryClient) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	var forecast models.Forecast
+	err := r.do(ctx, func() error {
+		var err error
+		forecast, err = r.wrapped.FetchForecast(ctx, city, days)
+		return err
+	})
+	return forecast, err
+}
+
+// do calls fn until it succeeds, fails for good, runs out of attempts or would
+// outlive ctx, and returns the last error.
+func (r *RetryClient) do(ctx context.Context, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= r.cfg.Attempts || ctx.Err() != nil {
+			return err
+		}
+
+		delay, ok := r.backoff(attempt, err)
+		if !ok {
+			return err
+		}
+		if deadline, has := ctx.Deadline(); has && time.Now().Add(delay).After(deadline) {
+			return err
+		}
+
+		r.logger.Warn().
+			Ctx(ctx).
+			Str("provider", r.name).
+			Int("attempt", attempt).
+			Dur("delay", delay).
+			Err(err).
+			Msg("retry: transient failure, retrying")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt, and false when err
+// is not worth retrying.
+func (r *RetryClient) backoff(attempt int, err error) (time.Duration, bool) {
+	var cause *statusCause
+	switch {
+	case errors.As(err, &cause) && cause.code == http.StatusTooManyRequests:
+		// Only a provider that says when to come back is worth asking again.
+		if !cause.hasRetryAfter || cause.retryAfter > r.cfg.MaxDelay {
+			return 0, false
+		}
+		return cause.retryAfter, true
+	case errors.As(err, &cause):
+		if cause.code < http.StatusInternalServerError {
+			return 0, false
+		}
+	case !errors.Is(err, models.ErrProviderUnavailable) || errors.Is(err, errUnsupported):
+		// Unknown cities, unusable payloads, spent quotas and unsupported lookups
+		// will not change on a retry.
+		return 0, false
+	}
+
+	ceiling := min(r.cfg.BaseDelay<<(attempt-1), r.cfg.MaxDelay)
+	if ceiling <= 0 { // shifted past the int64 range
+		ceiling = r.cfg.MaxDelay
+	}
+	return rand.N(ceiling) + 1, true
+}
@@ -0,0 +1,134 @@
+//go:build unit
+
+package weather
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
+)
+
+var retryCfg = RetryConfig{Attempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+func statusResponse(code int, retryAfter string) *http.Response {
+	resp := &http.Response{StatusCode: code, Status: http.StatusText(code), Header: http.Header{}}
+	if retryAfter != "" {
+		resp.Header.Set("Retry-After", retryAfter)
+	}
+	return resp
+}
+
+func TestRetryClient(t *testing.T) {
+	successModel := models.WeatherData{City: "Lviv", Temperature: 15}
+	reset := transportError("A", errors.New("read: connection reset by peer"))
+
+	tests := []struct {
+		name  string
+		errs  []error // returned before the call that succeeds, if any is left
+		calls int
+		fails bool
+	}{
+		{name: "TransportErrorRetried", errs: []error{reset}, calls: 2},
+		{name: "ServerErrorRetried", errs: []error{statusError("A", statusResponse(503, ""))}, calls: 2},
+		{name: "RetryAfterHonoured", errs: []error{statusError("A", statusResponse(429, "0"))}, calls: 2},
+		{name: "TooManyRequestsWithoutRetryAfter", errs: []error{statusError("A", statusResponse(429, ""))}, calls: 1, fails: true},
+		{name: "RetryAfterBeyondMaxDelay", errs: []error{statusError("A", statusResponse(429, "60"))}, calls: 1, fails: true},
+		{name: "ClientErrorNotRetried", errs: []error{statusError("A", statusResponse(401, ""))}, calls: 1, fails: true},
+		{name: "NotFoundNotRetried", errs: []error{statusError("A", statusResponse(404, ""))}, calls: 1, fails: true},
+		{
+			name:  "InvalidResponseNotRetried",
+			errs:  []error{invalidResponse("A", errors.New("unexpected EOF"))},
+			calls: 1,
+			fails: true,
+		},
+		{name: "GivesUpAfterAttempts", errs: []error{reset, reset, reset}, calls: 3, fails: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mockAPIClient{}
+			for _, e := range tt.errs {
+				m.On("Fetch", mock.Anything, "Lviv").Return(models.WeatherData{}, e).Once()
+			}
+			m.On("Fetch", mock.Anything, "Lviv").Return(successModel, nil).Maybe()
+
+			l, err := logger.NewLogger("", "weather_test_retry")
+			require.NoError(t, err)
+
+			data, err := NewRetryClient("A", retryCfg, l, m).Fetch(context.Background(), "Lviv", "")
+			if tt.fails {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.errs[len(tt.errs)-1])
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, successModel, data)
+			}
+			m.AssertNumberOfCalls(t, "Fetch", tt.calls)
+		})
+	}
+}
+
+func TestRetryClient_StaysInsideDeadline(t *testing.T) {
+	m := &mockAPIClient{}
+	m.On("FetchForecast", mock.Anything, "Lviv", 3).
+		Return(models.Forecast{}, statusError("A", statusResponse(http.StatusTooManyRequests, "1")))
+
+	l, err := logger.NewLogger("", "weather_test_retry_deadline")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	cfg := RetryConfig{Attempts: 10, MaxDelay: 5 * time.Second}
+	start := time.Now()
+	_, err = NewRetryClient("A", cfg, l, m).FetchForecast(ctx, "Lviv", 3)
+
+	assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+	assert.Less(t, time.Since(start), 20*time.Millisecond, "no wait is started that would outlive the deadline")
+	m.AssertNumberOfCalls(t, "FetchForecast", 1)
+}
+
+func TestRetryClient_InsideBreaker(t *testing.T) {
+	m := &mockAPIClient{}
+	m.On("Fetch", mock.Anything, "Lviv").
+		Return(models.WeatherData{}, transportError("A", errors.New("read: connection reset by peer")))
+
+	l, err := logger.NewLogger("", "weather_test_retry_breaker")
+	require.NoError(t, err)
+
+	breaker := NewBreakerClient("A", BreakerConfig{
+		TimeInterval: time.Minute,
+		TimeTimeOut:  time.Minute,
+		RepeatNumber: 2,
+	}, l, NewRetryClient("A", retryCfg, l, m))
+
+	_, err = breaker.Fetch(context.Background(), "Lviv", "")
+	require.Error(t, err)
+
+	status := breaker.Status()
+	assert.Equal(t, "closed", status.State, "exhausted retries count as one breaker failure")
+	assert.Equal(t, uint32(1), status.ConsecutiveFailures)
+	m.AssertNumberOfCalls(t, "Fetch", retryCfg.Attempts)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := parseRetryAfter("120")
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, d)
+
+	d, ok = parseRetryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Zero(t, d)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
@@ -2,32 +2,114 @@ package weather
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"net/http"
 	"path"
 	"reflect"
 	"runtime"
+	"strconv"
+	"time"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
 
 	"github.com/rs/zerolog"
 )
 
-type client interface {
-	Fetch(ctx context.Context, city string) (models.WeatherData, error)
+// Client is a weather provider, or a decorator around one, that NewService
+// combines with the others.
+type Client interface {
+	// Fetch asks for condition text in lang; an empty lang leaves the provider's default.
+	Fetch(ctx context.Context, city, lang string) (models.WeatherData, error)
+	FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error)
+	FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error)
+}
+
+// statusReporter is implemented by clients that can describe their own health.
+type statusReporter interface {
+	Status() models.ProviderStatus
+}
+
+// quotaReporter is implemented by clients that budget their calls.
+type quotaReporter interface {
+	Exhausted() bool
+}
+
+// exhausted reports whether cl budgets its calls and has spent its budget.
+func exhausted(cl Client) bool {
+	r, ok := cl.(quotaReporter)
+	return ok && r.Exhausted()
+}
+
+// namer is implemented by clients that know which provider they talk to.
+type namer interface {
+	Name() string
 }
 
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// Strategy selects how ServiceProvider combines its clients for current weather.
+type Strategy string
+
+const (
+	// StrategyFailover asks clients in order and returns the first success.
+	StrategyFailover Strategy = "failover"
+	// StrategyAggregate asks every healthy client at once and combines the answers.
+	StrategyAggregate Strategy = "aggregate"
+	// StrategyHedge asks clients in order but fires the next one when the current
+	// one is slow, keeping the first good answer.
+	StrategyHedge Strategy = "hedge"
+)
+
+// ParseStrategy validates a strategy name coming from configuration.
+func ParseStrategy(name string) (Strategy, error) {
+	switch strategy := Strategy(name); strategy {
+	case StrategyFailover, StrategyAggregate, StrategyHedge:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown weather strategy %q", name)
+	}
+}
+
+// StrategyConfig configures how ServiceProvider uses its clients.
+type StrategyConfig struct {
+	Strategy Strategy
+
+	// HedgeDelay is how long the hedge strategy waits on a client before firing the next one.
+	HedgeDelay time.Duration
+	// HedgeAdaptive replaces HedgeDelay with the client's observed p95 latency once
+	// enough samples have been collected.
+	HedgeAdaptive bool
+	// OnHedgeResult, when set, is told which raced providers won and lost.
+	OnHedgeResult func(provider string, won bool)
+
+	// Weights are manual provider priorities keyed by provider name. They multiply
+	// the score computed from observed health and latency; missing ones count as 1.
+	Weights map[string]float64
+}
+
 type ServiceProvider struct {
 	logger  zerolog.Logger
-	clients []client
+	cfg     StrategyConfig
+	clients []Client
+	stats   []*providerStats
 }
 
-func NewService(logger zerolog.Logger, clients ...client) *ServiceProvider {
-	return &ServiceProvider{clients: clients, logger: logger}
+func NewService(logger zerolog.Logger, cfg StrategyConfig, clients ...Client) *ServiceProvider {
+	stats := make([]*providerStats, len(clients))
+	for i := range stats {
+		stats[i] = &providerStats{}
+	}
+	return &ServiceProvider{clients: clients, cfg: cfg, stats: stats, logger: logger}
+}
+
+// clientName names a client for logs and metrics.
+func clientName(cl Client) string {
+	if n, ok := cl.(namer); ok {
+		return n.Name()
+	}
+	return getFuncName(cl.Fetch)
 }
 
 func getFuncName(fn interface{}) string {
@@ -35,32 +117,166 @@ func getFuncName(fn interface{}) string {
 	return path.Base(runtime.FuncForPC(pc).Name())
 }
 
-func (s *ServiceProvider) GetByCity(ctx context.Context, city string) (models.WeatherData, error) {
-	for _, cl := range s.clients {
+// observedAt converts a provider's unix timestamp, falling back to now when the provider omits it.
+func observedAt(epoch int64) time.Time {
+	if epoch == 0 {
+		return time.Now().UTC()
+	}
+	return time.Unix(epoch, 0).UTC()
+}
+
+// formatCoordinate renders a latitude or longitude the way providers expect it
+// in query strings.
+func formatCoordinate(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// formatCoordinates renders a point as "lat,lon".
+func formatCoordinates(lat, lon float64) string {
+	return formatCoordinate(lat) + "," + formatCoordinate(lon)
+}
+
+// langParam renders the query string parameter every provider takes the
+// condition text language in; providers default to English without it.
+func langParam(lang string) string {
+	if lang == "" {
+		return ""
+	}
+	return "&lang=" + lang
+}
+
+// query is what the current weather strategies ask each client for.
+type query struct {
+	// location labels the query in logs.
+	location string
+	fetch    func(ctx context.Context, cl Client) (models.WeatherData, error)
+}
+
+func cityQuery(city, lang string) query {
+	return query{
+		location: city,
+		fetch: func(ctx context.Context, cl Client) (models.WeatherData, error) {
+			return cl.Fetch(ctx, city, lang)
+		},
+	}
+}
+
+func coordinatesQuery(lat, lon float64) query {
+	return query{
+		location: formatCoordinates(lat, lon),
+		fetch: func(ctx context.Context, cl Client) (models.WeatherData, error) {
+			return cl.FetchByCoordinates(ctx, lat, lon)
+		},
+	}
+}
+
+// GetByCity returns the current weather in a city, with the condition described
+// in lang where providers support it.
+func (s *ServiceProvider) GetByCity(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	return s.current(ctx, cityQuery(city, lang))
+}
+
+// GetByCoordinates returns the current weather at the given point, using the same
+// strategy as GetByCity. The city is whatever the answering provider reports.
+func (s *ServiceProvider) GetByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	return s.current(ctx, coordinatesQuery(lat, lon))
+}
+
+func (s *ServiceProvider) current(ctx context.Context, q query) (models.WeatherData, error) {
+	switch s.cfg.Strategy {
+	case StrategyAggregate:
+		return s.aggregate(ctx, q)
+	case StrategyHedge:
+		return s.hedge(ctx, q)
+	default:
+		return s.failover(ctx, q)
+	}
+}
+
+// fetch runs q against the i-th client and records the outcome in its stats.
+func (s *ServiceProvider) fetch(ctx context.Context, i int, q query) (models.WeatherData, error) {
+	start := time.Now()
+	data, err := q.fetch(ctx, s.clients[i])
+	s.observe(ctx, i, start, err)
+	return data, err
+}
+
+// observe records the outcome of a call to the i-th client started at start. Calls
+// cancelled by the caller say nothing about the provider and are not recorded.
+func (s *ServiceProvider) observe(ctx context.Context, i int, start time.Time, err error) {
+	if ctx.Err() == nil {
+		s.stats[i].observe(isHealthy(err), time.Since(start))
+	}
+}
+
+func (s *ServiceProvider) failover(ctx context.Context, q query) (models.WeatherData, error) {
+	order, errs := s.candidates()
+	for _, i := range order {
+		cl := s.clients[i]
 		s.logger.Info().
 			Ctx(ctx).
-			Str("client", getFuncName(cl.Fetch)).
-			Str("city", city).
+			Str("client", clientName(cl)).
+			Str("location", q.location).
 			Msg("calling Fetch")
-		data, err := cl.Fetch(ctx, city)
+		data, err := s.fetch(ctx, i, q)
 		if err != nil {
 			s.logger.Error().
 				Ctx(ctx).
-				Str("client", getFuncName(cl.Fetch)).
+				Str("client", clientName(cl)).
 				Err(err).
 				Msg("fetch failed")
+			errs = append(errs, err)
 			continue
 		}
 		s.logger.Info().
 			Ctx(ctx).
-			Str("client", getFuncName(cl.Fetch)).
+			Str("client", clientName(cl)).
 			Msg("fetch succeeded")
 		return data, nil
 	}
-	err := errors.New("all weather API clients failed")
+	err := fmt.Errorf("all weather API clients failed: %w", dominantError(errs))
 	s.logger.Error().
 		Err(err).
 		Ctx(ctx).
-		Msg("GetByCity giving up")
+		Str("location", q.location).
+		Msg("current weather lookup giving up")
 	return models.WeatherData{}, err
 }
+
+// GetForecast asks clients for a forecast in ranked order, like failover, and
+// feeds the outcomes into the same stats as current weather lookups.
+func (s *ServiceProvider) GetForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	order, errs := s.candidates()
+	for _, i := range order {
+		cl := s.clients[i]
+		s.logger.Info().
+			Ctx(ctx).
+			Str("client", clientName(cl)).
+			Str("city", city).
+			Int("days", days).
+			Msg("calling FetchForecast")
+		start := time.Now()
+		forecast, err := cl.FetchForecast(ctx, city, days)
+		s.observe(ctx, i, start, err)
+		if err != nil {
+			s.logger.Error().
+				Ctx(ctx).
+				Str("client", clientName(cl)).
+				Err(err).
+				Msg("forecast fetch failed")
+			errs = append(errs, err)
+			continue
+		}
+		s.logger.Info().
+			Ctx(ctx).
+			Str("client", clientName(cl)).
+			Msg("forecast fetch succeeded")
+		return forecast, nil
+	}
+	err := fmt.Errorf("all weather API clients failed: %w", dominantError(errs))
+	s.logger.Error().
+		Err(err).
+		Ctx(ctx).
+		Msg("GetForecast giving up")
+	return models.Forecast{}, err
+}
@@ -3,7 +3,9 @@ package weather
 import (
 	"context"
 	"errors"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
 
@@ -20,7 +22,7 @@ type mockAPIClient struct {
 
 func (m *mockAPIClient) Fetch(
 	ctx context.Context,
-	city string,
+	city, _ string,
 ) (models.WeatherData, error) {
 	args := m.Called(ctx, city)
 	data, ok := args.Get(0).(models.WeatherData)
@@ -32,6 +34,35 @@ func (m *mockAPIClient) Fetch(
 	return data, args.Error(1)
 }
 
+func (m *mockAPIClient) FetchByCoordinates(
+	ctx context.Context,
+	lat, lon float64,
+) (models.WeatherData, error) {
+	args := m.Called(ctx, lat, lon)
+	data, ok := args.Get(0).(models.WeatherData)
+
+	if !ok {
+		return models.WeatherData{}, args.Error(1)
+	}
+
+	return data, args.Error(1)
+}
+
+func (m *mockAPIClient) FetchForecast(
+	ctx context.Context,
+	city string,
+	days int,
+) (models.Forecast, error) {
+	args := m.Called(ctx, city, days)
+	data, ok := args.Get(0).(models.Forecast)
+
+	if !ok {
+		return models.Forecast{}, args.Error(1)
+	}
+
+	return data, args.Error(1)
+}
+
 func TestServiceProvider_GetByCity(t *testing.T) {
 	ctx, _ := gin.CreateTestContext(nil)
 	successWeatherModel := models.WeatherData{City: "Lviv", Temperature: 15, Condition: "Sunny"}
@@ -51,9 +82,9 @@ func TestServiceProvider_GetByCity(t *testing.T) {
 		l, err := logger.NewLogger("", "weather_test_success")
 		require.NoError(t, err)
 
-		provider := NewService(l, &mock1, &mock2)
+		provider := NewService(l, StrategyConfig{Strategy: StrategyFailover}, &mock1, &mock2)
 
-		result, err := provider.GetByCity(ctx, "Lviv")
+		result, err := provider.GetByCity(ctx, "Lviv", "")
 
 		require.NoError(t, err)
 
@@ -75,9 +106,9 @@ func TestServiceProvider_GetByCity(t *testing.T) {
 		l, err := logger.NewLogger("", "weather_test_first_fails_second_success")
 		require.NoError(t, err)
 
-		provider := NewService(l, &mock1, &mock2)
+		provider := NewService(l, StrategyConfig{Strategy: StrategyFailover}, &mock1, &mock2)
 
-		result, err := provider.GetByCity(ctx, "Lviv")
+		result, err := provider.GetByCity(ctx, "Lviv", "")
 
 		require.NoError(t, err)
 
@@ -99,12 +130,433 @@ func TestServiceProvider_GetByCity(t *testing.T) {
 		l, err := logger.NewLogger("", "weather_test_all_fails")
 		require.NoError(t, err)
 
-		provider := NewService(l, &mock1, &mock2)
+		provider := NewService(l, StrategyConfig{Strategy: StrategyFailover}, &mock1, &mock2)
 
-		result, err := provider.GetByCity(ctx, "Lviv")
+		result, err := provider.GetByCity(ctx, "Lviv", "")
 
 		require.Error(t, err)
-		assert.Equal(t, err.Error(), "all weather API clients failed")
+		assert.Equal(t, "all weather API clients failed: error", err.Error())
 		assert.Equal(t, emptyModel, result)
 	})
 }
+
+func TestServiceProvider_GetForecast(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+	forecast := models.Forecast{
+		City: "Lviv",
+		Days: []models.DailyForecast{{Date: "2025-07-01", MinTemperature: 12, MaxTemperature: 24}},
+	}
+
+	t.Run("FirstFailsSecondSuccess", func(t *testing.T) {
+		mock1 := mockAPIClient{}
+		mock2 := mockAPIClient{}
+
+		mock1.On("FetchForecast", mock.Anything, "Lviv", 3).Return(models.Forecast{}, errors.New("error"))
+		mock2.On("FetchForecast", mock.Anything, "Lviv", 3).Return(forecast, nil)
+
+		t.Cleanup(func() {
+			mock1.AssertExpectations(t)
+			mock2.AssertExpectations(t)
+		})
+
+		l, err := logger.NewLogger("", "weather_test_forecast_fallback")
+		require.NoError(t, err)
+
+		provider := NewService(l, StrategyConfig{Strategy: StrategyFailover}, &mock1, &mock2)
+
+		result, err := provider.GetForecast(ctx, "Lviv", 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, forecast, result)
+	})
+
+	t.Run("AllFails", func(t *testing.T) {
+		mock1 := mockAPIClient{}
+
+		mock1.On("FetchForecast", mock.Anything, "Lviv", 3).Return(models.Forecast{}, errors.New("error"))
+
+		t.Cleanup(func() {
+			mock1.AssertExpectations(t)
+		})
+
+		l, err := logger.NewLogger("", "weather_test_forecast_all_fails")
+		require.NoError(t, err)
+
+		provider := NewService(l, StrategyConfig{Strategy: StrategyFailover}, &mock1)
+
+		result, err := provider.GetForecast(ctx, "Lviv", 3)
+
+		require.Error(t, err)
+		assert.Equal(t, "all weather API clients failed: error", err.Error())
+		assert.Equal(t, models.Forecast{}, result)
+	})
+}
+
+func TestServiceProvider_ErrorKinds(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+	notFound := models.NewProviderError("A", models.ErrCityNotFound, errors.New("status 404 Not Found"))
+	quota := models.NewProviderError("B", models.ErrQuotaExceeded, errors.New("status 429 Too Many Requests"))
+	down := models.NewProviderError("C", models.ErrProviderUnavailable, errors.New("status 503 Service Unavailable"))
+
+	tests := []struct {
+		name string
+		errs []error
+		want error
+	}{
+		{name: "NotFoundWins", errs: []error{down, notFound, quota}, want: models.ErrCityNotFound},
+		{name: "AllQuota", errs: []error{quota, quota}, want: models.ErrQuotaExceeded},
+		{name: "QuotaAndDown", errs: []error{quota, down}, want: models.ErrProviderUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients := make([]Client, 0, len(tt.errs))
+			for _, e := range tt.errs {
+				m := &mockAPIClient{}
+				m.On("Fetch", mock.Anything, "Lviv").Return(models.WeatherData{}, e)
+				clients = append(clients, m)
+			}
+
+			l, err := logger.NewLogger("", "weather_test_error_kinds")
+			require.NoError(t, err)
+
+			_, err = NewService(l, StrategyConfig{Strategy: StrategyFailover}, clients...).GetByCity(ctx, "Lviv", "")
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestServiceProvider_Aggregate(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+	observed := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	readings := []models.WeatherData{
+		{City: "Lviv", Temperature: 12, Condition: "Rain", Humidity: 80, Pressure: 1010, Provider: "A", ObservedAt: observed},
+		{City: "Lviv", Temperature: 30, Condition: "Clear", Humidity: 40, Pressure: 1020, Provider: "B", ObservedAt: observed},
+		{City: "Lviv", Temperature: 14, Condition: "rain", Humidity: 70, Pressure: 1012, Provider: "C",
+			ObservedAt: observed.Add(time.Minute)},
+	}
+
+	clients := make([]Client, 0, len(readings)+1)
+	for _, r := range readings {
+		m := &mockAPIClient{}
+		m.On("Fetch", mock.Anything, "Lviv").Return(r, nil).Once()
+		clients = append(clients, m)
+	}
+	failing := &mockAPIClient{}
+	failing.On("Fetch", mock.Anything, "Lviv").Return(models.WeatherData{}, errors.New("error")).Once()
+	clients = append(clients, failing)
+
+	l, err := logger.NewLogger("", "weather_test_aggregate")
+	require.NoError(t, err)
+
+	result, err := NewService(l, StrategyConfig{Strategy: StrategyAggregate}, clients...).GetByCity(ctx, "Lviv", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Lviv", result.City)
+	assert.Equal(t, 14.0, result.Temperature)
+	assert.Equal(t, "Rain", result.Condition)
+	assert.Equal(t, 70, result.Humidity)
+	assert.Equal(t, 1012.0, result.Pressure)
+	assert.Equal(t, observed.Add(time.Minute), result.ObservedAt)
+	assert.Equal(t, "aggregate", result.Provider)
+	assert.Equal(t, []string{"A", "B", "C"}, result.Contributors)
+	assert.Equal(t, 18.0, result.TemperatureSpread)
+
+	for _, cl := range clients {
+		cl.(*mockAPIClient).AssertExpectations(t)
+	}
+}
+
+func TestServiceProvider_AggregateAllFail(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	m := &mockAPIClient{}
+	m.On("Fetch", mock.Anything, "Atlantis").
+		Return(models.WeatherData{}, models.NewProviderError("A", models.ErrCityNotFound, errors.New("status 404"))).
+		Once()
+
+	l, err := logger.NewLogger("", "weather_test_aggregate_fail")
+	require.NoError(t, err)
+
+	_, err = NewService(l, StrategyConfig{Strategy: StrategyAggregate}, m).GetByCity(ctx, "Atlantis", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
+}
+
+func TestServiceProvider_Hedge(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+	slowModel := models.WeatherData{City: "Lviv", Temperature: 15, Provider: "slow"}
+	fastModel := models.WeatherData{City: "Lviv", Temperature: 16, Provider: "fast"}
+
+	slow := &mockAPIClient{}
+	slow.On("Fetch", mock.Anything, "Lviv").Return(slowModel, nil).After(500 * time.Millisecond).Once()
+	fast := &mockAPIClient{}
+	fast.On("Fetch", mock.Anything, "Lviv").Return(fastModel, nil).Once()
+	unused := &mockAPIClient{}
+
+	t.Cleanup(func() {
+		unused.AssertNotCalled(t, "Fetch", mock.Anything, mock.Anything)
+	})
+
+	l, err := logger.NewLogger("", "weather_test_hedge")
+	require.NoError(t, err)
+
+	var wins, losses int
+	provider := NewService(l, StrategyConfig{
+		Strategy:   StrategyHedge,
+		HedgeDelay: 20 * time.Millisecond,
+		OnHedgeResult: func(_ string, won bool) {
+			if won {
+				wins++
+			} else {
+				losses++
+			}
+		},
+	}, slow, fast, unused)
+
+	start := time.Now()
+	result, err := provider.GetByCity(ctx, "Lviv", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, fastModel, result)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, 1, wins)
+	assert.Equal(t, 1, losses)
+}
+
+func TestServiceProvider_HedgeFailureFiresNext(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+	successModel := models.WeatherData{City: "Lviv", Temperature: 15}
+
+	failing := &mockAPIClient{}
+	failing.On("Fetch", mock.Anything, "Lviv").Return(models.WeatherData{}, errors.New("error")).Once()
+	second := &mockAPIClient{}
+	second.On("Fetch", mock.Anything, "Lviv").Return(successModel, nil).Once()
+
+	l, err := logger.NewLogger("", "weather_test_hedge_failure")
+	require.NoError(t, err)
+
+	provider := NewService(l, StrategyConfig{Strategy: StrategyHedge, HedgeDelay: time.Minute}, failing, second)
+
+	result, err := provider.GetByCity(ctx, "Lviv", "")
+	require.NoError(t, err)
+	assert.Equal(t, successModel, result)
+
+	failing.AssertExpectations(t)
+	second.AssertExpectations(t)
+}
+
+func TestServiceProvider_HedgeAllFail(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	first := &mockAPIClient{}
+	first.On("Fetch", mock.Anything, "Atlantis").
+		Return(models.WeatherData{}, models.NewProviderError("A", models.ErrCityNotFound, errors.New("status 404"))).
+		Once()
+	second := &mockAPIClient{}
+	second.On("Fetch", mock.Anything, "Atlantis").Return(models.WeatherData{}, errors.New("error")).Once()
+
+	l, err := logger.NewLogger("", "weather_test_hedge_all_fail")
+	require.NoError(t, err)
+
+	_, err = NewService(l, StrategyConfig{Strategy: StrategyHedge, HedgeDelay: time.Millisecond}, first, second).
+		GetByCity(ctx, "Atlantis", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
+}
+
+func TestProviderStats(t *testing.T) {
+	stats := &providerStats{}
+	for i := 1; i < minSamples; i++ {
+		stats.observe(true, time.Duration(i)*time.Millisecond)
+	}
+	_, ok := stats.latency(hedgeQuantile)
+	assert.False(t, ok)
+
+	for i := minSamples; i <= windowSize+minSamples; i++ {
+		stats.observe(true, time.Duration(i)*time.Millisecond)
+	}
+	p95, ok := stats.latency(hedgeQuantile)
+	require.True(t, ok)
+	assert.Equal(t, 115*time.Millisecond, p95)
+
+	for range windowSize / 4 {
+		stats.observe(false, time.Hour)
+	}
+	rate, samples := stats.successRate()
+	assert.Equal(t, windowSize, samples)
+	assert.InDelta(t, 0.75, rate, 0.001)
+	p95, _ = stats.latency(hedgeQuantile)
+	assert.Equal(t, 115*time.Millisecond, p95)
+}
+
+type namedClient struct {
+	*mockAPIClient
+	name string
+}
+
+func (n namedClient) Name() string { return n.name }
+
+func TestServiceProvider_Order(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+	successModel := models.WeatherData{City: "Lviv", Temperature: 15}
+
+	first := namedClient{&mockAPIClient{}, "First"}
+	second := namedClient{&mockAPIClient{}, "Second"}
+	third := namedClient{&mockAPIClient{}, "Third"}
+
+	l, err := logger.NewLogger("", "weather_test_order")
+	require.NoError(t, err)
+
+	provider := NewService(l, StrategyConfig{
+		Strategy: StrategyFailover,
+		Weights:  map[string]float64{"Third": 2},
+	}, first, second, third)
+
+	names := func() []string {
+		var names []string
+		for _, st := range provider.ProviderStatus() {
+			names = append(names, st.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"Third", "First", "Second"}, names())
+
+	// Third keeps failing, so it drops below the providers that answer.
+	third.On("Fetch", mock.Anything, "Lviv").Return(models.WeatherData{}, errors.New("error"))
+	first.On("Fetch", mock.Anything, "Lviv").Return(successModel, nil)
+	for range minSamples {
+		_, err := provider.GetByCity(ctx, "Lviv", "")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"First", "Second", "Third"}, names())
+
+	statuses := provider.ProviderStatus()
+	assert.Equal(t, 1, statuses[0].Priority)
+	assert.Equal(t, minSamples, statuses[0].Samples)
+	assert.InDelta(t, 1.0, statuses[0].SuccessRate, 0.001)
+	assert.Equal(t, 3, statuses[2].Priority)
+	assert.Equal(t, 2.0, statuses[2].Weight)
+	assert.Zero(t, statuses[2].SuccessRate)
+	assert.Zero(t, statuses[2].Score)
+
+	second.AssertNotCalled(t, "Fetch", mock.Anything, mock.Anything)
+}
+
+func TestServiceProvider_GetForecastFollowsRanking(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+	forecast := models.Forecast{City: "Lviv", Days: []models.DailyForecast{{Date: "2025-07-01"}}}
+
+	first := namedClient{&mockAPIClient{}, "First"}
+	second := namedClient{&mockAPIClient{}, "Second"}
+
+	l, err := logger.NewLogger("", "weather_test_forecast_ranking")
+	require.NoError(t, err)
+
+	provider := NewService(l, StrategyConfig{Strategy: StrategyFailover}, first, second)
+
+	// First keeps failing forecasts, so it drops below Second.
+	first.On("FetchForecast", mock.Anything, "Lviv", 3).Return(models.Forecast{}, errors.New("error")).Times(minSamples)
+	second.On("FetchForecast", mock.Anything, "Lviv", 3).Return(forecast, nil)
+	for range minSamples {
+		result, err := provider.GetForecast(ctx, "Lviv", 3)
+		require.NoError(t, err)
+		assert.Equal(t, forecast, result)
+	}
+	assert.Equal(t, "Second", provider.ProviderStatus()[0].Name)
+
+	_, err = provider.GetForecast(ctx, "Lviv", 3)
+	require.NoError(t, err)
+	first.AssertNumberOfCalls(t, "FetchForecast", minSamples)
+}
+
+type exhaustedClient struct {
+	namedClient
+}
+
+func (exhaustedClient) Exhausted() bool { return true }
+
+func TestServiceProvider_SkipsExhaustedClients(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+	successModel := models.WeatherData{City: "Lviv", Temperature: 15, Provider: "Second"}
+
+	for _, strategy := range []Strategy{StrategyFailover, StrategyHedge, StrategyAggregate} {
+		t.Run(string(strategy), func(t *testing.T) {
+			first := exhaustedClient{namedClient{&mockAPIClient{}, "First"}}
+			second := namedClient{&mockAPIClient{}, "Second"}
+			second.On("Fetch", mock.Anything, "Lviv").Return(successModel, nil).Once()
+
+			l, err := logger.NewLogger("", "weather_test_exhausted")
+			require.NoError(t, err)
+
+			provider := NewService(l, StrategyConfig{Strategy: strategy, HedgeDelay: time.Second}, first, second)
+			result, err := provider.GetByCity(ctx, "Lviv", "")
+			require.NoError(t, err)
+			assert.Equal(t, 15.0, result.Temperature)
+
+			first.AssertNotCalled(t, "Fetch", mock.Anything, mock.Anything)
+			second.AssertExpectations(t)
+
+			statuses := provider.ProviderStatus()
+			assert.Equal(t, "Second", statuses[0].Name)
+			assert.False(t, statuses[0].QuotaExhausted)
+			assert.True(t, statuses[1].QuotaExhausted)
+		})
+	}
+
+	t.Run("AllExhausted", func(t *testing.T) {
+		only := exhaustedClient{namedClient{&mockAPIClient{}, "Only"}}
+
+		l, err := logger.NewLogger("", "weather_test_all_exhausted")
+		require.NoError(t, err)
+
+		provider := NewService(l, StrategyConfig{Strategy: StrategyFailover}, only)
+		_, err = provider.GetByCity(ctx, "Lviv", "")
+		assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+
+		_, err = provider.GetForecast(ctx, "Lviv", 3)
+		assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+
+		only.AssertNotCalled(t, "Fetch", mock.Anything, mock.Anything)
+		only.AssertNotCalled(t, "FetchForecast", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestServiceProvider_GetByCoordinates(t *testing.T) {
+	ctx := context.Background()
+	lviv := models.WeatherData{City: "Lviv", Temperature: 21}
+
+	mock1 := &mockAPIClient{}
+	mock2 := &mockAPIClient{}
+	mock1.On("FetchByCoordinates", mock.Anything, 49.84, 24.03).
+		Return(models.WeatherData{}, models.NewProviderError("first", models.ErrProviderUnavailable, errors.New("down")))
+	mock2.On("FetchByCoordinates", mock.Anything, 49.84, 24.03).Return(lviv, nil)
+
+	t.Cleanup(func() {
+		mock1.AssertExpectations(t)
+		mock2.AssertExpectations(t)
+		mock1.AssertNotCalled(t, "Fetch", mock.Anything, mock.Anything)
+	})
+
+	l, err := logger.NewLogger("", "weather_test_coordinates")
+	require.NoError(t, err)
+
+	provider := NewService(l, StrategyConfig{Strategy: StrategyFailover}, mock1, mock2)
+
+	result, err := provider.GetByCoordinates(ctx, 49.84, 24.03)
+	require.NoError(t, err)
+	assert.Equal(t, lviv, result)
+}
+
+func TestTransportError_RedactsURL(t *testing.T) {
+	err := transportError("WeatherAPI", &url.Error{
+		Op:  "Get",
+		URL: "https://api.weatherapi.com/v1/current.json?key=secret&q=Lviv",
+		Err: errors.New("connection refused"),
+	})
+
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.Contains(t, err.Error(), "https://api.weatherapi.com/v1/current.json")
+}
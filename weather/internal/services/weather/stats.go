@@ -0,0 +1,82 @@
+package weather
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// windowSize is how many recent calls a provider's stats remember.
+	windowSize = 100
+	// minSamples is how many samples a statistic needs before it is trusted.
+	minSamples = 20
+
+	// rankingQuantile is the latency quantile used to rank providers.
+	rankingQuantile = 0.5
+)
+
+// window is a fixed-size ring of the most recent samples.
+type window[T any] struct {
+	mu      sync.Mutex
+	samples []T
+	next    int
+}
+
+func (w *window[T]) observe(v T) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.samples) < windowSize {
+		w.samples = append(w.samples, v)
+		return
+	}
+	w.samples[w.next] = v
+	w.next = (w.next + 1) % windowSize
+}
+
+func (w *window[T]) snapshot() []T {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return slices.Clone(w.samples)
+}
+
+// providerStats tracks a client's recent outcomes and the latency of its successful calls.
+type providerStats struct {
+	latencies window[time.Duration]
+	outcomes  window[bool]
+}
+
+// observe records one call. Only healthy calls contribute a latency sample, so
+// fast failures do not make a provider look quick.
+func (p *providerStats) observe(healthy bool, d time.Duration) {
+	p.outcomes.observe(healthy)
+	if healthy {
+		p.latencies.observe(d)
+	}
+}
+
+// latency returns the q-th latency quantile, or false while there are too few samples.
+func (p *providerStats) latency(q float64) (time.Duration, bool) {
+	sorted := p.latencies.snapshot()
+	if len(sorted) < minSamples {
+		return 0, false
+	}
+	slices.Sort(sorted)
+	return sorted[int(q*float64(len(sorted)-1))], true
+}
+
+// successRate returns the share of healthy calls and how many calls it is based on.
+func (p *providerStats) successRate() (float64, int) {
+	outcomes := p.outcomes.snapshot()
+	if len(outcomes) == 0 {
+		return 0, 0
+	}
+	var healthy int
+	for _, ok := range outcomes {
+		if ok {
+			healthy++
+		}
+	}
+	return float64(healthy) / float64(len(outcomes)), len(outcomes)
+}
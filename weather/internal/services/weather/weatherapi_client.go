@@ -12,33 +12,62 @@ import (
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
 )
 
+const (
+	providerWeatherAPI = "WeatherAPI"
+
+	// WeatherAPI error codes, see https://www.weatherapi.com/docs/#intro-error-codes.
+	weatherAPINoLocationFound = 1006
+	weatherAPIQuotaExceeded   = 2007
+
+	// kphPerMps converts WeatherAPI's km/h wind speed into m/s used across providers.
+	kphPerMps = 3.6
+)
+
 // ClientWeatherAPI fetches weather data from WeatherAPI.com.
 type ClientWeatherAPI struct {
-	APIKey string
-	apiURL string
-	client HTTPClient
-	logger zerolog.Logger
+	APIKey      string
+	apiURL      string
+	forecastURL string
+	client      HTTPClient
+	logger      zerolog.Logger
 }
 
 // NewClientWeatherAPI constructs a new WeatherAPI client.
 func NewClientWeatherAPI(
-	apiKey, apiURL string,
+	apiKey, apiURL, forecastURL string,
 	httpClient HTTPClient,
 	logger zerolog.Logger,
 ) *ClientWeatherAPI {
-	return &ClientWeatherAPI{APIKey: apiKey, apiURL: apiURL, client: httpClient, logger: logger}
+	return &ClientWeatherAPI{
+		APIKey:      apiKey,
+		apiURL:      apiURL,
+		forecastURL: forecastURL,
+		client:      httpClient,
+		logger:      logger,
+	}
 }
 
 // Fetch retrieves weather data for a given city, with structured logging.
-func (s *ClientWeatherAPI) Fetch(ctx context.Context, city string) (models.WeatherData, error) {
+func (s *ClientWeatherAPI) Fetch(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	return s.current(ctx, city, lang)
+}
+
+// FetchByCoordinates retrieves weather data for the given point. WeatherAPI takes
+// "lat,lon" wherever it takes a city name.
+func (s *ClientWeatherAPI) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	return s.current(ctx, formatCoordinates(lat, lon), "")
+}
+
+// current requests the current weather for a WeatherAPI location query.
+func (s *ClientWeatherAPI) current(ctx context.Context, location, lang string) (models.WeatherData, error) {
 	start := time.Now()
 
 	// Build URL
-	url := fmt.Sprintf("%s?key=%s&q=%s", s.apiURL, s.APIKey, city)
+	url := fmt.Sprintf("%s?key=%s&q=%s%s", s.apiURL, s.APIKey, location, langParam(lang))
 
 	s.logger.Debug().
 		Ctx(ctx).
-		Str("city", city).
+		Str("location", location).
 		Str("url", url).
 		Msg("starting WeatherAPI request")
 
@@ -47,7 +76,7 @@ func (s *ClientWeatherAPI) Fetch(ctx context.Context, city string) (models.Weath
 		s.logger.Error().
 			Err(err).
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Str("url", url).
 			Msg("failed to create HTTP request")
 		return models.WeatherData{}, err
@@ -58,17 +87,17 @@ func (s *ClientWeatherAPI) Fetch(ctx context.Context, city string) (models.Weath
 		s.logger.Error().
 			Err(err).
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Str("url", url).
 			Msg("error sending HTTP request to WeatherAPI")
-		return models.WeatherData{}, err
+		return models.WeatherData{}, transportError(providerWeatherAPI, err)
 	}
 	defer func() {
 		if cerr := resp.Body.Close(); cerr != nil {
 			s.logger.Error().
 				Err(cerr).
 				Ctx(ctx).
-				Str("city", city).
+				Str("location", location).
 				Msg("failed to close response body")
 		}
 	}()
@@ -76,10 +105,10 @@ func (s *ClientWeatherAPI) Fetch(ctx context.Context, city string) (models.Weath
 	if resp.StatusCode != http.StatusOK {
 		s.logger.Error().
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Str("status", resp.Status).
 			Msg("WeatherAPI returned non-200 status")
-		return models.WeatherData{}, fmt.Errorf("weather API error: status %s", resp.Status)
+		return models.WeatherData{}, weatherAPIStatusError(resp)
 	}
 
 	// Decode response
@@ -88,9 +117,16 @@ func (s *ClientWeatherAPI) Fetch(ctx context.Context, city string) (models.Weath
 			Name string `json:"name"`
 		} `json:"location"`
 		Current struct {
-			TempC     float64 `json:"temp_c"`
-			Condition struct {
+			LastUpdatedEpoch int64   `json:"last_updated_epoch"`
+			TempC            float64 `json:"temp_c"`
+			FeelsLikeC       float64 `json:"feelslike_c"`
+			Humidity         int     `json:"humidity"`
+			WindKph          float64 `json:"wind_kph"`
+			WindDegree       int     `json:"wind_degree"`
+			PressureMb       float64 `json:"pressure_mb"`
+			Condition        struct {
 				Text string `json:"text"`
+				Code int    `json:"code"`
 			} `json:"condition"`
 		} `json:"current"`
 	}
@@ -99,23 +135,167 @@ func (s *ClientWeatherAPI) Fetch(ctx context.Context, city string) (models.Weath
 		s.logger.Error().
 			Ctx(ctx).
 			Err(err).
-			Str("city", city).
+			Str("location", location).
 			Msg("failed to decode WeatherAPI response")
-		return models.WeatherData{}, err
+		return models.WeatherData{}, invalidResponse(providerWeatherAPI, err)
 	}
 
 	data := models.WeatherData{
-		City:        raw.Location.Name,
-		Temperature: raw.Current.TempC,
-		Condition:   raw.Current.Condition.Text,
+		City:          raw.Location.Name,
+		Temperature:   raw.Current.TempC,
+		Condition:     raw.Current.Condition.Text,
+		ConditionCode: weatherAPICondition(raw.Current.Condition.Code),
+		Humidity:      raw.Current.Humidity,
+		WindSpeed:     raw.Current.WindKph / kphPerMps,
+		WindDirection: raw.Current.WindDegree,
+		Pressure:      raw.Current.PressureMb,
+		FeelsLike:     raw.Current.FeelsLikeC,
+		ObservedAt:    observedAt(raw.Current.LastUpdatedEpoch),
+		Provider:      providerWeatherAPI,
 	}
 
 	duration := time.Since(start)
 	s.logger.Info().
 		Ctx(ctx).
-		Str("city", city).
+		Str("location", location).
 		Dur("duration_ms", duration).
 		Msg("successfully fetched weather data from WeatherAPI")
 
 	return data, nil
 }
+
+// FetchForecast retrieves a daily forecast for a given city from WeatherAPI's forecast endpoint.
+func (s *ClientWeatherAPI) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	start := time.Now()
+
+	url := fmt.Sprintf("%s?key=%s&q=%s&days=%d", s.forecastURL, s.APIKey, city, days)
+
+	s.logger.Debug().
+		Ctx(ctx).
+		Str("city", city).
+		Int("days", days).
+		Str("url", url).
+		Msg("starting WeatherAPI forecast request")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("city", city).
+			Str("url", url).
+			Msg("failed to create HTTP request")
+		return models.Forecast{}, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("city", city).
+			Str("url", url).
+			Msg("error sending forecast request to WeatherAPI")
+		return models.Forecast{}, transportError(providerWeatherAPI, err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			s.logger.Error().
+				Err(cerr).
+				Ctx(ctx).
+				Str("city", city).
+				Msg("failed to close response body")
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("city", city).
+			Str("status", resp.Status).
+			Msg("WeatherAPI forecast returned non-200 status")
+		return models.Forecast{}, weatherAPIStatusError(resp)
+	}
+
+	var raw struct {
+		Location struct {
+			Name string `json:"name"`
+		} `json:"location"`
+		Forecast struct {
+			ForecastDay []struct {
+				Date string `json:"date"`
+				Day  struct {
+					MaxTempC          float64 `json:"maxtemp_c"`
+					MinTempC          float64 `json:"mintemp_c"`
+					TotalPrecipMM     float64 `json:"totalprecip_mm"`
+					DailyChanceOfRain float64 `json:"daily_chance_of_rain"`
+					DailyChanceOfSnow float64 `json:"daily_chance_of_snow"`
+					Condition         struct {
+						Text string `json:"text"`
+						Code int    `json:"code"`
+					} `json:"condition"`
+				} `json:"day"`
+			} `json:"forecastday"`
+		} `json:"forecast"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		s.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Str("city", city).
+			Msg("failed to decode WeatherAPI forecast response")
+		return models.Forecast{}, invalidResponse(providerWeatherAPI, err)
+	}
+
+	if len(raw.Forecast.ForecastDay) == 0 {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("city", city).
+			Msg("no forecast days in WeatherAPI response")
+		return models.Forecast{}, invalidResponse(providerWeatherAPI, errEmptyPayload)
+	}
+
+	forecast := models.Forecast{City: raw.Location.Name}
+	for _, fd := range raw.Forecast.ForecastDay {
+		forecast.Days = append(forecast.Days, models.DailyForecast{
+			Date:                fd.Date,
+			MinTemperature:      fd.Day.MinTempC,
+			MaxTemperature:      fd.Day.MaxTempC,
+			Condition:           fd.Day.Condition.Text,
+			ConditionCode:       weatherAPICondition(fd.Day.Condition.Code),
+			PrecipitationChance: max(fd.Day.DailyChanceOfRain, fd.Day.DailyChanceOfSnow),
+			PrecipitationMM:     fd.Day.TotalPrecipMM,
+		})
+	}
+
+	s.logger.Info().
+		Ctx(ctx).
+		Str("city", city).
+		Int("days", len(forecast.Days)).
+		Dur("duration_ms", time.Since(start)).
+		Msg("successfully fetched forecast from WeatherAPI")
+
+	return forecast, nil
+}
+
+// weatherAPIStatusError classifies a non-200 WeatherAPI response. WeatherAPI reports an
+// unknown city as 400 and an exhausted quota as 403, so the error code in the body decides.
+func weatherAPIStatusError(resp *http.Response) error {
+	var body struct {
+		Error struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err == nil {
+		cause := fmt.Errorf("status %s: %s", resp.Status, body.Error.Message)
+		switch body.Error.Code {
+		case weatherAPINoLocationFound:
+			return models.NewProviderError(providerWeatherAPI, models.ErrCityNotFound, cause)
+		case weatherAPIQuotaExceeded:
+			return models.NewProviderError(providerWeatherAPI, models.ErrQuotaExceeded, cause)
+		}
+	}
+	return statusError(providerWeatherAPI, resp)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
 	"github.com/stretchr/testify/require"
@@ -54,7 +55,9 @@ func TestGetByCity_Success(t *testing.T) {
 			StatusCode: http.StatusOK,
 			Body: io.NopCloser(strings.NewReader(
 				`{"location": {"name": "London"},
-						"current": {"temp_c": 15.0, "condition": {"text": "Sunny"}}}`)),
+						"current": {"temp_c": 15.0, "condition": {"text": "Sunny", "code": 1000},
+						"last_updated_epoch": 1751371200, "feelslike_c": 14.2, "humidity": 72,
+						"wind_kph": 18.0, "wind_degree": 250, "pressure_mb": 1009.0}}`)),
 		}, nil).Once()
 
 	t.Cleanup(func() {
@@ -64,13 +67,21 @@ func TestGetByCity_Success(t *testing.T) {
 	l, err := logger.NewLogger("test", "weather_api_test_success")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "London")
+	data, err := weatherAPIClient.Fetch(ctx, "London", "")
 	assert.NoError(t, err)
 	assert.Equal(t, "London", data.City)
 	assert.Equal(t, 15.0, data.Temperature)
 	assert.Equal(t, "Sunny", data.Condition)
+	assert.Equal(t, models.ConditionClear, data.ConditionCode)
+	assert.Equal(t, 72, data.Humidity)
+	assert.InDelta(t, 5.0, data.WindSpeed, 1e-9)
+	assert.Equal(t, 250, data.WindDirection)
+	assert.Equal(t, 1009.0, data.Pressure)
+	assert.Equal(t, 14.2, data.FeelsLike)
+	assert.Equal(t, time.Unix(1751371200, 0).UTC(), data.ObservedAt)
+	assert.Equal(t, "WeatherAPI", data.Provider)
 }
 
 func TestGetByCity_CityNotFound(t *testing.T) {
@@ -90,13 +101,60 @@ func TestGetByCity_CityNotFound(t *testing.T) {
 
 	l, err := logger.NewLogger("test", "weather_api_test_city_not_found")
 	require.NoError(t, err)
-	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "UnknownCity")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "UnknownCity", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
 	assert.Equal(t, models.WeatherData{}, data)
 }
 
+func TestGetByCity_ErrorCodesInBody(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{
+			name:   "NoLocationFound",
+			status: http.StatusBadRequest,
+			body:   `{"error": {"code": 1006, "message": "No matching location found."}}`,
+			want:   models.ErrCityNotFound,
+		},
+		{
+			name:   "QuotaExceeded",
+			status: http.StatusForbidden,
+			body:   `{"error": {"code": 2007, "message": "API key has exceeded calls per month quota."}}`,
+			want:   models.ErrQuotaExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(nil)
+
+			m := &mockHTTPClient{}
+			m.On("Do", mock.Anything).Return(
+				&http.Response{
+					StatusCode: tt.status,
+					Body:       io.NopCloser(strings.NewReader(tt.body)),
+				}, nil).Once()
+
+			t.Cleanup(func() {
+				m.AssertExpectations(t)
+			})
+
+			l, err := logger.NewLogger("test", "weather_api_test_error_codes")
+			require.NoError(t, err)
+
+			weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
+
+			_, err = weatherAPIClient.Fetch(ctx, "Nowhere", "")
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
 func TestGetByCity_APIError(t *testing.T) {
 	ctx, _ := gin.CreateTestContext(nil)
 
@@ -115,10 +173,10 @@ func TestGetByCity_APIError(t *testing.T) {
 	l, err := logger.NewLogger("test", "weather_api_test_api_error")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "London")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "London", "")
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
 	assert.Equal(t, models.WeatherData{}, data)
 }
 
@@ -140,10 +198,10 @@ func TestGetByCity_InvalidAPIKey(t *testing.T) {
 	l, err := logger.NewLogger("test", "weather_api_test_invalid_api_key")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "London")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "London", "")
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
 	assert.Equal(t, models.WeatherData{}, data)
 }
 
@@ -162,7 +220,77 @@ func TestGetByCity_InvalidAPIKey(t *testing.T) {
 //	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", m, log.Default())
 //	weatherService := weather.NewService(log.Default(), weatherAPIClient)
 //
-//	data, err := weatherService.GetByCity(ctx, "London")
+//	data, err := weatherService.GetByCity(ctx, "London", "")
 //	assert.Equal(t, context.DeadlineExceeded, err)
 //	assert.Equal(t, models.WeatherData{}, data)
 // }
+
+func TestFetchForecast_Success(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	m := &mockHTTPClient{}
+
+	m.On("Do", mock.Anything).Return(
+		&http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(
+				`{"location": {"name": "London"},
+				  "forecast": {"forecastday": [
+					{"date": "2025-07-01", "day": {"maxtemp_c": 21.3, "mintemp_c": 13.1, "totalprecip_mm": 2.4,
+					 "daily_chance_of_rain": 64, "daily_chance_of_snow": 0, "condition": {"text": "Patchy rain", "code": 1063}}}
+				  ]}}`)),
+		}, nil).Once()
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("test", "weather_api_test_forecast")
+	require.NoError(t, err)
+
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
+
+	forecast, err := weatherAPIClient.FetchForecast(ctx, "London", 1)
+	require.NoError(t, err)
+	assert.Equal(t, models.Forecast{
+		City: "London",
+		Days: []models.DailyForecast{{
+			Date:                "2025-07-01",
+			MinTemperature:      13.1,
+			MaxTemperature:      21.3,
+			Condition:           "Patchy rain",
+			ConditionCode:       models.ConditionRain,
+			PrecipitationChance: 64,
+			PrecipitationMM:     2.4,
+		}},
+	}, forecast)
+}
+
+func TestFetchByCoordinates_Success(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	m := &mockHTTPClient{}
+
+	m.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Query().Get("q") == "49.84,24.03"
+	})).Return(
+		&http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(
+				`{"location": {"name": "Lviv"}, "current": {"temp_c": 21.5, "condition": {"text": "Sunny"}}}`)),
+		}, nil).Once()
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("test", "weather_api_test_coordinates")
+	require.NoError(t, err)
+
+	weatherAPIClient := weather.NewClientWeatherAPI("1234567890", "", "", m, l)
+
+	data, err := weatherAPIClient.FetchByCoordinates(ctx, 49.84, 24.03)
+	require.NoError(t, err)
+	assert.Equal(t, "Lviv", data.City)
+	assert.Equal(t, 21.5, data.Temperature)
+}
@@ -12,42 +12,89 @@ import (
 	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/models"
 )
 
+const providerWeatherBit = "WeatherBit"
+
 type bitWeatherAPIResponse struct {
 	Data []struct {
 		CityName string  `json:"city_name"`
 		Temp     float64 `json:"temp"`
+		AppTemp  float64 `json:"app_temp"`
+		Rh       int     `json:"rh"`
+		WindSpd  float64 `json:"wind_spd"`
+		WindDir  int     `json:"wind_dir"`
+		Pres     float64 `json:"pres"`
+		Ts       int64   `json:"ts"`
 		Weather  struct {
 			Description string `json:"description"`
+			Code        int    `json:"code"`
+		} `json:"weather"`
+	} `json:"data"`
+}
+
+type bitForecastAPIResponse struct {
+	CityName string `json:"city_name"`
+	Data     []struct {
+		ValidDate string  `json:"valid_date"`
+		MaxTemp   float64 `json:"max_temp"`
+		MinTemp   float64 `json:"min_temp"`
+		Precip    float64 `json:"precip"`
+		Pop       float64 `json:"pop"`
+		Weather   struct {
+			Description string `json:"description"`
+			Code        int    `json:"code"`
 		} `json:"weather"`
 	} `json:"data"`
 }
 
 // ClientWeatherBit fetches weather data from WeatherBit API.
 type ClientWeatherBit struct {
-	APIKey string
-	apiURL string
-	client HTTPClient
-	logger zerolog.Logger
+	APIKey      string
+	apiURL      string
+	forecastURL string
+	client      HTTPClient
+	logger      zerolog.Logger
 }
 
 // NewClientWeatherBit constructs a new WeatherBit client.
 func NewClientWeatherBit(
-	apiKey, apiURL string,
+	apiKey, apiURL, forecastURL string,
 	httpClient HTTPClient,
 	logger zerolog.Logger,
 ) *ClientWeatherBit {
-	return &ClientWeatherBit{APIKey: apiKey, apiURL: apiURL, client: httpClient, logger: logger}
+	return &ClientWeatherBit{
+		APIKey:      apiKey,
+		apiURL:      apiURL,
+		forecastURL: forecastURL,
+		client:      httpClient,
+		logger:      logger,
+	}
 }
 
 // Fetch retrieves weather data for a given city, with structured logging and timing.
-func (s *ClientWeatherBit) Fetch(ctx context.Context, city string) (models.WeatherData, error) {
+func (s *ClientWeatherBit) Fetch(ctx context.Context, city, lang string) (models.WeatherData, error) {
+	url := fmt.Sprintf("%s?city=%s&key=%s%s", s.apiURL, city, s.APIKey, langParam(lang))
+	data, err := s.current(ctx, url, city)
+	if err != nil {
+		return models.WeatherData{}, err
+	}
+	data.City = city
+	return data, nil
+}
+
+// FetchByCoordinates retrieves weather data for the given point. The city is the
+// one WeatherBit places the point in.
+func (s *ClientWeatherBit) FetchByCoordinates(ctx context.Context, lat, lon float64) (models.WeatherData, error) {
+	url := fmt.Sprintf("%s?lat=%s&lon=%s&key=%s", s.apiURL, formatCoordinate(lat), formatCoordinate(lon), s.APIKey)
+	return s.current(ctx, url, formatCoordinates(lat, lon))
+}
+
+// current requests the current weather at url; location only labels the logs.
+func (s *ClientWeatherBit) current(ctx context.Context, url, location string) (models.WeatherData, error) {
 	start := time.Now()
-	// Build request URL
-	url := fmt.Sprintf("%s?city=%s&key=%s", s.apiURL, city, s.APIKey)
 
 	s.logger.Debug().
 		Ctx(ctx).
-		Str("city", city).
+		Str("location", location).
 		Str("url", url).
 		Msg("starting WeatherBit request")
 
@@ -56,7 +103,7 @@ func (s *ClientWeatherBit) Fetch(ctx context.Context, city string) (models.Weath
 		s.logger.Error().
 			Ctx(ctx).
 			Err(err).
-			Str("city", city).
+			Str("location", location).
 			Msg("failed to create HTTP request")
 		return models.WeatherData{}, err
 	}
@@ -66,16 +113,16 @@ func (s *ClientWeatherBit) Fetch(ctx context.Context, city string) (models.Weath
 		s.logger.Error().
 			Ctx(ctx).
 			Err(err).
-			Str("city", city).
+			Str("location", location).
 			Msg("error sending HTTP request to WeatherBit")
-		return models.WeatherData{}, err
+		return models.WeatherData{}, transportError(providerWeatherBit, err)
 	}
 	defer func() {
 		if cerr := resp.Body.Close(); cerr != nil {
 			s.logger.Error().
 				Ctx(ctx).
 				Err(cerr).
-				Str("city", city).
+				Str("location", location).
 				Msg("failed to close response body")
 		}
 	}()
@@ -88,10 +135,10 @@ func (s *ClientWeatherBit) Fetch(ctx context.Context, city string) (models.Weath
 	if resp.StatusCode != http.StatusOK {
 		s.logger.Error().
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Int("status_code", resp.StatusCode).
 			Msg("WeatherBit API returned non-200 status")
-		return models.WeatherData{}, fmt.Errorf("WeatherBit API error: status %s", resp.Status)
+		return models.WeatherData{}, weatherBitStatusError(resp)
 	}
 
 	var raw bitWeatherAPIResponse
@@ -99,33 +146,141 @@ func (s *ClientWeatherBit) Fetch(ctx context.Context, city string) (models.Weath
 		s.logger.Error().
 			Err(err).
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Msg("failed to decode WeatherBit response")
-		return models.WeatherData{}, err
+		return models.WeatherData{}, invalidResponse(providerWeatherBit, err)
 	}
 
 	// Extract first entry
 	if len(raw.Data) == 0 {
 		s.logger.Error().
 			Ctx(ctx).
-			Str("city", city).
+			Str("location", location).
 			Msg("no data in WeatherBit response")
-		return models.WeatherData{}, fmt.Errorf("WeatherBit API returned empty data for city %s", city)
+		return models.WeatherData{}, invalidResponse(providerWeatherBit, errEmptyPayload)
 	}
 
 	entry := raw.Data[0]
 	data := models.WeatherData{
-		City:        city,
-		Temperature: entry.Temp,
-		Condition:   entry.Weather.Description,
+		City:          entry.CityName,
+		Temperature:   entry.Temp,
+		Condition:     entry.Weather.Description,
+		ConditionCode: weatherBitCondition(entry.Weather.Code),
+		Humidity:      entry.Rh,
+		WindSpeed:     entry.WindSpd,
+		WindDirection: entry.WindDir,
+		Pressure:      entry.Pres,
+		FeelsLike:     entry.AppTemp,
+		ObservedAt:    observedAt(entry.Ts),
+		Provider:      providerWeatherBit,
 	}
 
 	duration := time.Since(start)
 	s.logger.Info().
 		Ctx(ctx).
-		Str("city", city).
+		Str("location", location).
 		Dur("duration_ms", duration).
 		Msg("successfully fetched weather data from WeatherBit")
 
 	return data, nil
 }
+
+// FetchForecast retrieves a daily forecast for a given city from WeatherBit's daily forecast endpoint.
+func (s *ClientWeatherBit) FetchForecast(ctx context.Context, city string, days int) (models.Forecast, error) {
+	start := time.Now()
+	url := fmt.Sprintf("%s?city=%s&key=%s&days=%d", s.forecastURL, city, s.APIKey, days)
+
+	s.logger.Debug().
+		Ctx(ctx).
+		Str("city", city).
+		Int("days", days).
+		Str("url", url).
+		Msg("starting WeatherBit forecast request")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		s.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Str("city", city).
+			Msg("failed to create HTTP request")
+		return models.Forecast{}, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Str("city", city).
+			Msg("error sending forecast request to WeatherBit")
+		return models.Forecast{}, transportError(providerWeatherBit, err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			s.logger.Error().
+				Ctx(ctx).
+				Err(cerr).
+				Str("city", city).
+				Msg("failed to close response body")
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("city", city).
+			Int("status_code", resp.StatusCode).
+			Msg("WeatherBit forecast returned non-200 status")
+		return models.Forecast{}, weatherBitStatusError(resp)
+	}
+
+	var raw bitForecastAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		s.logger.Error().
+			Err(err).
+			Ctx(ctx).
+			Str("city", city).
+			Msg("failed to decode WeatherBit forecast response")
+		return models.Forecast{}, invalidResponse(providerWeatherBit, err)
+	}
+
+	if len(raw.Data) == 0 {
+		s.logger.Error().
+			Ctx(ctx).
+			Str("city", city).
+			Msg("no data in WeatherBit forecast response")
+		return models.Forecast{}, invalidResponse(providerWeatherBit, errEmptyPayload)
+	}
+
+	forecast := models.Forecast{City: city}
+	for _, entry := range raw.Data {
+		forecast.Days = append(forecast.Days, models.DailyForecast{
+			Date:                entry.ValidDate,
+			MinTemperature:      entry.MinTemp,
+			MaxTemperature:      entry.MaxTemp,
+			Condition:           entry.Weather.Description,
+			ConditionCode:       weatherBitCondition(entry.Weather.Code),
+			PrecipitationChance: entry.Pop,
+			PrecipitationMM:     entry.Precip,
+		})
+	}
+
+	s.logger.Info().
+		Ctx(ctx).
+		Str("city", city).
+		Int("days", len(forecast.Days)).
+		Dur("duration_ms", time.Since(start)).
+		Msg("successfully fetched forecast from WeatherBit")
+
+	return forecast, nil
+}
+
+// weatherBitStatusError classifies a non-200 WeatherBit response. WeatherBit answers
+// 204 No Content rather than 404 when it does not know the requested city.
+func weatherBitStatusError(resp *http.Response) error {
+	if resp.StatusCode == http.StatusNoContent {
+		return models.NewProviderError(providerWeatherBit, models.ErrCityNotFound, fmt.Errorf("status %s", resp.Status))
+	}
+	return statusError(providerWeatherBit, resp)
+}
@@ -34,7 +34,8 @@ func Test_WeatherBit_GetByCity_Success(t *testing.T) {
 						  "city_name": "Odesa",
 						  "temp": 27.5,
 						  "weather": {
-							"description": "sunny"
+							"description": "sunny",
+							"code": 800
 						  }
 						}
 					  ]
@@ -48,13 +49,14 @@ func Test_WeatherBit_GetByCity_Success(t *testing.T) {
 	l, err := logger.NewLogger("", "weatherbit_test_success")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "Odesa")
+	data, err := weatherAPIClient.Fetch(ctx, "Odesa", "")
 	assert.NoError(t, err)
 	assert.Equal(t, "Odesa", data.City)
 	assert.Equal(t, 27.5, data.Temperature)
 	assert.Equal(t, "sunny", data.Condition)
+	assert.Equal(t, models.ConditionClear, data.ConditionCode)
 }
 
 func Test_WeatherBit_CityNotFound(t *testing.T) {
@@ -74,13 +76,37 @@ func Test_WeatherBit_CityNotFound(t *testing.T) {
 
 	l, err := logger.NewLogger("", "weatherbit_test_city_not_found")
 	require.NoError(t, err)
-	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "UnknownCity")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "UnknownCity", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
 	assert.Equal(t, models.WeatherData{}, data)
 }
 
+func Test_WeatherBit_NoContentIsCityNotFound(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	m := &mockHTTPClient{}
+
+	m.On("Do", mock.Anything).Return(
+		&http.Response{
+			StatusCode: http.StatusNoContent,
+			Status:     "204 No Content",
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil).Once()
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("", "weatherbit_test_no_content")
+	require.NoError(t, err)
+	weatherBitClient := weather.NewClientWeatherBit("1234567890", "", "", m, l)
+
+	_, err = weatherBitClient.Fetch(ctx, "UnknownCity", "")
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
+}
+
 func Test_WeatherBit_APIError(t *testing.T) {
 	ctx, _ := gin.CreateTestContext(nil)
 
@@ -99,10 +125,10 @@ func Test_WeatherBit_APIError(t *testing.T) {
 	l, err := logger.NewLogger("", "weatherbit_test_city_api_error")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "London")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "London", "")
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
 	assert.Equal(t, models.WeatherData{}, data)
 }
 
@@ -124,9 +150,47 @@ func Test_WeatherBit_GetByCity_InvalidAPIKey(t *testing.T) {
 	l, err := logger.NewLogger("", "weatherbit_test_invalid_api_key")
 	require.NoError(t, err)
 
-	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", m, l)
+	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", "", m, l)
 
-	data, err := weatherAPIClient.Fetch(ctx, "London")
-	assert.Error(t, err)
+	data, err := weatherAPIClient.Fetch(ctx, "London", "")
+	assert.ErrorIs(t, err, models.ErrProviderUnavailable)
 	assert.Equal(t, models.WeatherData{}, data)
 }
+
+func Test_WeatherBit_FetchForecast_Success(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(nil)
+
+	m := &mockHTTPClient{}
+
+	m.On("Do", mock.Anything).Return(
+		&http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(
+				`{
+					"city_name": "Odesa",
+					"data": [
+						{"valid_date": "2025-07-01", "max_temp": 29.0, "min_temp": 21.5, "precip": 0.25, "pop": 20,
+						 "weather": {"description": "Few clouds", "code": 801}},
+						{"valid_date": "2025-07-02", "max_temp": 27.0, "min_temp": 20.0, "precip": 6.0, "pop": 90,
+						 "weather": {"description": "Thunderstorm", "code": 201}}
+					]
+				}`)),
+		}, nil).Once()
+
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+
+	l, err := logger.NewLogger("", "weatherbit_test_forecast")
+	require.NoError(t, err)
+
+	weatherAPIClient := weather.NewClientWeatherBit("1234567890", "", "", m, l)
+
+	forecast, err := weatherAPIClient.FetchForecast(ctx, "Odesa", 2)
+	require.NoError(t, err)
+	require.Len(t, forecast.Days, 2)
+	assert.Equal(t, "Odesa", forecast.City)
+	assert.Equal(t, 90.0, forecast.Days[1].PrecipitationChance)
+	assert.Equal(t, "Thunderstorm", forecast.Days[1].Condition)
+	assert.Equal(t, models.ConditionThunderstorm, forecast.Days[1].ConditionCode)
+}
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE observations (
+                              id             INTEGER PRIMARY KEY AUTOINCREMENT,
+                              city_id        TEXT    NOT NULL,
+                              city           TEXT    NOT NULL,
+                              provider       TEXT    NOT NULL,
+                              observed_at    INTEGER NOT NULL, -- unix seconds
+                              fetched_at     INTEGER NOT NULL, -- unix seconds
+                              temperature    REAL    NOT NULL,
+                              feels_like     REAL    NOT NULL,
+                              condition      TEXT    NOT NULL,
+                              humidity       INTEGER NOT NULL,
+                              wind_speed     REAL    NOT NULL,
+                              wind_direction INTEGER NOT NULL,
+                              pressure       REAL    NOT NULL,
+                              UNIQUE (city_id, provider, observed_at)
+);
+CREATE INDEX idx_observations_city_observed_at ON observations (city_id, observed_at);
+-- +goose Down
+DROP TABLE observations;
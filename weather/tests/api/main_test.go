@@ -36,6 +36,8 @@ func TestMain(m *testing.M) {
 
 	testWeatherBitAPIServer := newWeatherBitTestServer()
 
+	testOpenMeteoServer := newOpenMeteoTestServer()
+
 	cfg.WeatherAPIURL = testWeatherAPIServer.URL
 	cfg.WeatherAPIKey = "secret-key-weatherapi"
 
@@ -45,6 +47,9 @@ func TestMain(m *testing.M) {
 	cfg.WeatherBitAPIKey = "secret-key-weatherbit"
 	cfg.WeatherBitURL = testWeatherBitAPIServer.URL
 
+	cfg.OpenMeteoURL = testOpenMeteoServer.URL + "/v1/forecast"
+	cfg.OpenMeteoGeocodingURL = testOpenMeteoServer.URL + "/v1/search"
+
 	cfg.Server.Host = "127.0.0.1"
 	cfg.Server.GrpcPort = "50051"
 
@@ -74,6 +79,7 @@ func TestMain(m *testing.M) {
 	testWeatherAPIServer.Close()
 	testOpenWeatherAPIServer.Close()
 	testWeatherBitAPIServer.Close()
+	testOpenMeteoServer.Close()
 	cancel()
 	os.Exit(code)
 }
@@ -192,6 +198,28 @@ func newWeatherBitTestServer() *httptest.Server {
 	return httptest.NewServer(handler)
 }
 
+func newOpenMeteoTestServer() *httptest.Server {
+	const (
+		mockPlaces  = `{"results": [{"name": "Kyiv", "latitude": 50.45, "longitude": 30.52}]}`
+		mockCurrent = `{"current": {"temperature_2m": 18.5, "relative_humidity_2m": 55, "weather_code": 2}}`
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/search", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Open-Meteo leaves results out for a city it does not know
+		if r.URL.Query().Get("name") == "InvalidCity" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		_, _ = w.Write([]byte(mockPlaces))
+	})
+	mux.HandleFunc("/v1/forecast", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(mockCurrent))
+	})
+	return httptest.NewServer(mux)
+}
+
 func initIntegration(serverURL string) {
 	testServerURL = serverURL
 }
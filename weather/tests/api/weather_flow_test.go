@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
 )
@@ -39,6 +41,7 @@ func TestWeatherService_GetByCity(t *testing.T) {
 		city     string
 		wantResp *weatherpb.WeatherResponse
 		wantErr  string
+		wantCode codes.Code
 	}{
 		{
 			name: "valid city",
@@ -55,6 +58,7 @@ func TestWeatherService_GetByCity(t *testing.T) {
 			city:     "InvalidCity",
 			wantResp: nil,
 			wantErr:  "all weather API clients failed",
+			wantCode: codes.NotFound,
 		},
 	}
 
@@ -69,6 +73,7 @@ func TestWeatherService_GetByCity(t *testing.T) {
 			if tc.wantErr != "" {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tc.wantErr)
+				assert.Equal(t, tc.wantCode, status.Code(err))
 			} else {
 				require.NoError(t, err)
 				require.NotNil(t, resp)
@@ -0,0 +1,81 @@
+//go:build integration
+
+package offline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/pkg/logger"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/services/metrics"
+
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/app"
+	"github.com/Nazarious-ucu/weather-subscription-api/weather/internal/config"
+)
+
+var testServerURL string
+
+// TestMain runs the service on the fake provider alone, as the offline stack
+// does: no provider stubs and no network.
+func TestMain(m *testing.M) {
+	log.Println("Starting offline integration tests for weather service..")
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		log.Panicf("failed to load configuration: %v", err)
+	}
+
+	cfg.Fake.Snapshot = "testdata/snapshot.yaml"
+	cfg.Fake.Preload = true
+
+	cfg.Server.Host = "127.0.0.1"
+	cfg.Server.GrpcPort = "50052"
+
+	l, err := logger.NewLogger("", "weather_offline_integration_test")
+	if err != nil {
+		log.Panicf("failed to create logger: %v", err)
+	}
+	met := metrics.NewMetrics("weather_offline_integration_test")
+
+	application := app.New(*cfg, l, met)
+	ctxWithTimeout, cancel := context.WithTimeout(
+		context.Background(),
+		time.Duration(cfg.Server.ReadTimeout)*time.Second)
+
+	go func() {
+		if err := application.Start(ctxWithTimeout); err != nil {
+			log.Panic(err)
+		}
+	}()
+
+	testServerURL = cfg.Server.Host + ":" + cfg.Server.GrpcPort
+
+	// Startup includes the cache preload, so wait for the server rather than
+	// for a fixed time
+	if err := waitForServer(testServerURL, 10*time.Second); err != nil {
+		log.Panic(err)
+	}
+
+	code := m.Run()
+	cancel()
+	os.Exit(code)
+}
+
+func waitForServer(addr string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+		if err == nil {
+			return conn.Close()
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("weather service at %s did not start: %w", addr, err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
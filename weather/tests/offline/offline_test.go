@@ -0,0 +1,54 @@
+//go:build integration
+
+package offline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	weatherpb "github.com/Nazarious-ucu/weather-subscription-api/protos/gen/go/v1.alpha/weather"
+)
+
+func newClient(t *testing.T) weatherpb.WeatherServiceClient {
+	t.Helper()
+	conn, err := grpc.NewClient(testServerURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err, "failed to connect to weather gRPC service")
+	t.Cleanup(func() { _ = conn.Close() })
+	return weatherpb.NewWeatherServiceClient(conn)
+}
+
+func TestOffline_GetByCity(t *testing.T) {
+	client := newClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	resp, err := client.GetByCity(ctx, &weatherpb.WeatherRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	assert.Equal(t, "Kyiv", resp.City)
+	assert.Equal(t, 18.5, resp.Temperature)
+	assert.Equal(t, "Partly cloudy", resp.Condition)
+
+	_, err = client.GetByCity(ctx, &weatherpb.WeatherRequest{City: "InvalidCity"})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestOffline_GetForecast(t *testing.T) {
+	client := newClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	resp, err := client.GetForecast(ctx, &weatherpb.ForecastRequest{City: "Kyiv", Days: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Days, 2)
+	assert.Equal(t, "2025-07-01", resp.Days[0].Date)
+	assert.Equal(t, 17.0, resp.Days[1].MaxTemperature)
+}